@@ -9,6 +9,7 @@ package controller
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -17,27 +18,163 @@ import (
 
 //	plot request
 type plot2DRequest struct {
-	X_label string           `json:"x_label"`
-	Y_label string           `json:"y_label"`
-	Plot    []plotDefinition `json:"plot"`
-	Width   int64            `json:"width"`
-	Height  int64            `json:"height"`
+	X_label         string                 `json:"x_label"`
+	Y_label         string                 `json:"y_label"`
+	X2_label        string                 `json:"x2_label"`
+	Y2_label        string                 `json:"y2_label"`
+	Plot            []plotDefinition       `json:"plot"`
+	Annotations     []annotationDefinition `json:"annotations"`
+	X_zero_axis     *lineStyle             `json:"x_zero_axis"`
+	Y_zero_axis     *lineStyle             `json:"y_zero_axis"`
+	Reference_lines []referenceLine        `json:"reference_lines"`
+	Reference_bands []referenceBand        `json:"reference_bands"`
+	Histogram       *histogramStyle        `json:"histogram"`
+	Candlesticks    *candlesticksStyle     `json:"candlesticks"`
+	Boxplot         *boxplotStyle          `json:"boxplot"`
+	Palette         []paletteColour        `json:"palette"`
+	No_colour_box   bool                   `json:"no_colour_box"`
+	Contour         *contourStyle          `json:"contour"`
+	Samples         int                    `json:"samples"`
+	X_tic_labels    []string               `json:"x_tic_labels"`
+	Width           int64                  `json:"width"`
+	Height          int64                  `json:"height"`
+	Grid            bool                   `json:"grid"`
+	Terminal        terminalOptions        `json:"terminal"`
+}
+
+type terminalOptions struct {
+	Font_family string `json:"font_family"`
+	Font_size   uint8  `json:"font_size"`
+	Background  string `json:"background"`
+	Quality     int    `json:"quality"`
+	Dynamic     bool   `json:"dynamic"`
+	Name        string `json:"name"`
 }
 
 type plotDefinition struct {
-	Title        string           `json:"title"`
-	DataSet      dataSetPlot      `json:"data_set"`
-	MathFunction mathFunctionPlot `json:"math_function"`
+	Title              string                 `json:"title"`
+	Line_style         lineStyle              `json:"line_style"`
+	Fill               *fillStyle             `json:"fill"`
+	Axes               string                 `json:"axes"`
+	DataSet            dataSetPlot            `json:"data_set"`
+	MathFunction       mathFunctionPlot       `json:"math_function"`
+	ParametricFunction parametricFunctionPlot `json:"parametric_function"`
+	VectorField        vectorFieldPlot        `json:"vector_field"`
+	Vector             vectorStyle            `json:"vector"`
+	ContourFunction    contourFunctionPlot    `json:"contour_function"`
+	ImplicitFunction   implicitFunctionPlot   `json:"implicit_function"`
+}
+
+type lineStyle struct {
+	Colour        string  `json:"colour"`
+	Colour_source string  `json:"colour_source"`
+	Width         float64 `json:"width"`
+	Dash_type     uint8   `json:"dash_type"`
+	Point_type    uint8   `json:"point_type"`
+	Point_size    float64 `json:"point_size"`
+	Variable_size bool    `json:"variable_size"`
+}
+
+type fillStyle struct {
+	Type        string  `json:"type"`
+	Density     float64 `json:"density"`
+	Pattern     uint8   `json:"pattern"`
+	Transparent bool    `json:"transparent"`
+}
+
+type histogramStyle struct {
+	Layout string   `json:"layout"`
+	Gap    *float64 `json:"gap"`
+}
+
+type candlesticksStyle struct {
+	Rising  string `json:"rising"`
+	Falling string `json:"falling"`
+}
+
+type boxplotStyle struct {
+	Range       float64 `json:"range"`
+	No_outliers bool    `json:"no_outliers"`
+}
+
+type paletteColour struct {
+	Value  float64 `json:"value"`
+	Colour string  `json:"colour"`
+}
+
+type contourStyle struct {
+	Levels    string    `json:"levels"`
+	Count     int       `json:"count"`
+	Values    []float64 `json:"values"`
+	Start     float64   `json:"start"`
+	Increment float64   `json:"increment"`
+	End       *float64  `json:"end"`
+	No_labels bool      `json:"no_labels"`
+}
+
+type vectorStyle struct {
+	Head        string  `json:"head"`
+	Head_length float64 `json:"head_length"`
+	Head_angle  float64 `json:"head_angle"`
+}
+
+type annotationDefinition struct {
+	Type          string       `json:"type"`
+	Text          string       `json:"text"`
+	Position      []coordinate `json:"position"`
+	Size          coordinate   `json:"size"`
+	Angle         float64      `json:"angle"`
+	Justification string       `json:"justification"`
+	Font_family   string       `json:"font_family"`
+	Font_size     uint8        `json:"font_size"`
+	Head          string       `json:"head"`
+	Line_style    lineStyle    `json:"line_style"`
+	Fill_colour   string       `json:"fill_colour"`
+	No_border     bool         `json:"no_border"`
+	Front         *bool        `json:"front"`
+}
+
+type coordinate struct {
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+	X_system string  `json:"x_system"`
+	Y_system string  `json:"y_system"`
+}
+
+type referenceLine struct {
+	Orientation string    `json:"orientation"`
+	Value       float64   `json:"value"`
+	Title       string    `json:"title"`
+	Line_style  lineStyle `json:"line_style"`
+}
+
+type referenceBand struct {
+	Orientation string  `json:"orientation"`
+	From        float64 `json:"from"`
+	To          float64 `json:"to"`
+	Title       string  `json:"title"`
+	Colour      string  `json:"colour"`
 }
 
 type dataSetPlot struct {
-	Points []plotPoint `json:"points"`
-	Style  string      `json:"style"`
+	Points       []plotPoint `json:"points"`
+	Style        string      `json:"style"`
+	Whisker_bars float64     `json:"whisker_bars"`
 }
 
 type plotPoint struct {
-	X float64 `json:"x"`
-	Y float64 `json:"y"`
+	X      float64  `json:"x"`
+	Y      float64  `json:"y"`
+	X_low  *float64 `json:"x_low"`
+	X_high *float64 `json:"x_high"`
+	Y_low  *float64 `json:"y_low"`
+	Y_high *float64 `json:"y_high"`
+	Y_to   *float64 `json:"y_to"`
+	Open   *float64 `json:"open"`
+	Dx     *float64 `json:"dx"`
+	Dy     *float64 `json:"dy"`
+	Size   *float64 `json:"size"`
+	Colour *float64 `json:"colour"`
 }
 
 type mathFunctionPlot struct {
@@ -46,6 +183,42 @@ type mathFunctionPlot struct {
 	Function string  `json:"function"`
 }
 
+type parametricFunctionPlot struct {
+	Min_t      float64 `json:"min_t"`
+	Max_t      float64 `json:"max_t"`
+	Function_x string  `json:"function_x"`
+	Function_y string  `json:"function_y"`
+}
+
+type vectorFieldPlot struct {
+	Min_x       float64 `json:"min_x"`
+	Max_x       float64 `json:"max_x"`
+	Min_y       float64 `json:"min_y"`
+	Max_y       float64 `json:"max_y"`
+	Columns     int     `json:"columns"`
+	Rows        int     `json:"rows"`
+	Function_dx string  `json:"function_dx"`
+	Function_dy string  `json:"function_dy"`
+}
+
+type contourFunctionPlot struct {
+	Min_x    float64 `json:"min_x"`
+	Max_x    float64 `json:"max_x"`
+	Min_y    float64 `json:"min_y"`
+	Max_y    float64 `json:"max_y"`
+	Columns  int     `json:"columns"`
+	Rows     int     `json:"rows"`
+	Function string  `json:"function"`
+}
+
+type implicitFunctionPlot struct {
+	Min_x    float64 `json:"min_x"`
+	Max_x    float64 `json:"max_x"`
+	Min_y    float64 `json:"min_y"`
+	Max_y    float64 `json:"max_y"`
+	Relation string  `json:"relation"`
+}
+
 //	PlotHandler handle the HTTP request to generate a Go-Plot graphic
 func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, terminal uint8) {
 
@@ -77,31 +250,156 @@ func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, te
 		return
 	}
 
+	//	validate the terminal options
+	terminalOptions, err := newTerminalOptions(&requestData.Terminal, terminal)
+	if err != nil {
+		httpResponse.WriteHeader(http.StatusBadRequest)
+		httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+		return
+	}
+
+	//	the functions are sampled once for each pixel column unless the number of samples is informed
+	if requestData.Samples < 0 || requestData.Samples == 1 {
+		httpResponse.WriteHeader(http.StatusBadRequest)
+		httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "samples expected to be at least 2" }`)))
+		return
+	}
+
 	//	create a plot request from the request payload
 	plotRequest := &plot.Plot_2D{
-		X_label:    requestData.X_label,
-		Y_label:    requestData.Y_label,
-		Set_points: make([]plot.Set_points_2d, 0),
-		Function:   make([]plot.Function_2d, 0),
-		Width:      requestData.Width,
-		Height:     requestData.Height,
-		Terminal:   terminal,
+		X_label:          requestData.X_label,
+		Y_label:          requestData.Y_label,
+		X2_label:         requestData.X2_label,
+		Y2_label:         requestData.Y2_label,
+		Set_points:       make([]plot.Set_points_2d, 0),
+		Function:         make([]plot.Function_2d, 0),
+		Samples:          requestData.Samples,
+		Width:            requestData.Width,
+		Height:           requestData.Height,
+		Terminal:         terminal,
+		Terminal_options: *terminalOptions,
+		Histogram:        plot.Histogram_style{Gap: plot.DEFAULT_HISTOGRAM_GAP},
+	}
+
+	//	when requested, draw grid lines for the major tics
+	if requestData.Grid {
+		plotRequest.Grid = plot.Grid{
+			X_tics: true,
+			Y_tics: true,
+		}
+	}
+
+	//	add the labels, arrows and objects
+	for _, annotationDefinition := range requestData.Annotations {
+
+		annotation, err := newAnnotation(&annotationDefinition)
+		if err != nil {
+			httpResponse.WriteHeader(http.StatusBadRequest)
+			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+			return
+		}
+
+		plotRequest.Annotations = append(plotRequest.Annotations, *annotation)
+	}
+
+	//	add the zero axes, reference lines and shaded bands
+	err = addReferences(plotRequest, &requestData)
+	if err != nil {
+		httpResponse.WriteHeader(http.StatusBadRequest)
+		httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+		return
+	}
+
+	//	validate the styles of histograms, candlesticks and boxplots and the labels of the x axis
+	err = addStyles(plotRequest, &requestData)
+	if err != nil {
+		httpResponse.WriteHeader(http.StatusBadRequest)
+		httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+		return
 	}
 
 	for _, plotDefinition := range requestData.Plot {
 
-		if len(plotDefinition.DataSet.Points) == 0 && len(plotDefinition.MathFunction.Function) == 0 {
+		//	count the kinds of plot in the definition
+		var kinds int
+
+		if len(plotDefinition.DataSet.Points) > 0 {
+			kinds++
+		}
+		if len(plotDefinition.MathFunction.Function) > 0 {
+			kinds++
+		}
+		parametric := len(plotDefinition.ParametricFunction.Function_x) > 0 || len(plotDefinition.ParametricFunction.Function_y) > 0
+		if parametric {
+			kinds++
+		}
+		vectorField := len(plotDefinition.VectorField.Function_dx) > 0 || len(plotDefinition.VectorField.Function_dy) > 0
+		if vectorField {
+			kinds++
+		}
+		if len(plotDefinition.ContourFunction.Function) > 0 {
+			kinds++
+		}
+		if len(plotDefinition.ImplicitFunction.Relation) > 0 {
+			kinds++
+		}
+
+		if kinds == 0 {
 			httpResponse.WriteHeader(http.StatusBadRequest)
 			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "each plot must contain at least one function or one data set" }`)))
 			return
 		}
 
-		if len(plotDefinition.DataSet.Points) > 0 && len(plotDefinition.MathFunction.Function) > 0 {
+		if kinds > 1 {
+			httpResponse.WriteHeader(http.StatusBadRequest)
+			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "each plot must be either function, parametric function, vector field, contour function, implicit function or data set" }`)))
+			return
+		}
+
+		if parametric && (len(plotDefinition.ParametricFunction.Function_x) == 0 || len(plotDefinition.ParametricFunction.Function_y) == 0) {
+			httpResponse.WriteHeader(http.StatusBadRequest)
+			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "parametric function requires functions for x and y" }`)))
+			return
+		}
+
+		//	validate the line style
+		lineStyle, err := newLineStyle(&plotDefinition.Line_style)
+		if err != nil {
 			httpResponse.WriteHeader(http.StatusBadRequest)
-			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "each plot must be either function or data set" }`)))
+			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
 			return
 		}
 
+		//	validate the fill style
+		fill, err := newFillStyle(plotDefinition.Fill)
+		if err != nil {
+			httpResponse.WriteHeader(http.StatusBadRequest)
+			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+			return
+		}
+
+		//	validate the heads of vectors
+		vector, err := newVectorStyle(&plotDefinition.Vector)
+		if err != nil {
+			httpResponse.WriteHeader(http.StatusBadRequest)
+			httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+			return
+		}
+
+		//	validate the pair of axes (primary ones when not informed)
+		var axes uint8
+
+		if len(plotDefinition.Axes) > 0 {
+			var found bool
+
+			axes, found = plot.Axes_pair[plotDefinition.Axes]
+			if !found {
+				httpResponse.WriteHeader(http.StatusBadRequest)
+				httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "invalid axes: %s" }`, plotDefinition.Axes)))
+				return
+			}
+		}
+
 		//	add a new set of points
 		if len(plotDefinition.DataSet.Points) > 0 {
 
@@ -120,6 +418,12 @@ func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, te
 				}
 			}
 
+			if plotDefinition.DataSet.Whisker_bars < 0 || plotDefinition.DataSet.Whisker_bars > 1 {
+				httpResponse.WriteHeader(http.StatusBadRequest)
+				httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "whisker bars width expected to be between 0 and 1" }`)))
+				return
+			}
+
 			set_Points := plot.Set_points_2d{}
 
 			//	set a default title when necessary
@@ -131,6 +435,11 @@ func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, te
 
 			set_Points.Title = title
 			set_Points.Style = num_style
+			set_Points.Line_style = *lineStyle
+			set_Points.Fill = *fill
+			set_Points.Whisker_bars = plotDefinition.DataSet.Whisker_bars
+			set_Points.Vector = *vector
+			set_Points.Axes = axes
 
 			//	add the points
 			set_Points.Point = make([]plot.Point_2d, len(plotDefinition.DataSet.Points))
@@ -138,6 +447,29 @@ func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, te
 			for i, point := range plotDefinition.DataSet.Points {
 				set_Points.Point[i].X = point.X
 				set_Points.Point[i].Y = point.Y
+				set_Points.Point[i].Error = point.errorExtents()
+				set_Points.Point[i].Y_to = point.Y_to
+				set_Points.Point[i].Size = point.Size
+				set_Points.Point[i].Colour = point.Colour
+
+				//	the open price of candlesticks and financebars is the other limit of the box
+				if point.Open != nil {
+					set_Points.Point[i].Y_to = point.Open
+				}
+
+				//	the components of a vector give it's tip
+				if point.Dx != nil || point.Dy != nil {
+					x_to, y_to := point.X, point.Y
+
+					if point.Dx != nil {
+						x_to += *point.Dx
+					}
+					if point.Dy != nil {
+						y_to += *point.Dy
+					}
+					set_Points.Point[i].X_to = &x_to
+					set_Points.Point[i].Y_to = &y_to
+				}
 			}
 
 			plotRequest.Set_points = append(plotRequest.Set_points, set_Points)
@@ -157,12 +489,120 @@ func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, te
 
 			function.Title = title
 			function.Style = plot.DOTS
+			function.Line_style = *lineStyle
+			function.Axes = axes
 			function.Function = plotDefinition.MathFunction.Function
 			function.Min_x = plotDefinition.MathFunction.Min_x
 			function.Max_x = plotDefinition.MathFunction.Max_x
 
 			plotRequest.Function = append(plotRequest.Function, function)
 		}
+
+		//	add a new parametric function
+		if parametric {
+
+			function := plot.Function_2d{}
+
+			//	set a default title when necessary
+			title := plotDefinition.Title
+
+			if len(title) == 0 {
+				title = plotDefinition.ParametricFunction.Function_x + ", " + plotDefinition.ParametricFunction.Function_y
+			}
+
+			function.Title = title
+			function.Style = plot.DOTS
+			function.Line_style = *lineStyle
+			function.Axes = axes
+			function.Parametric = true
+			function.Function = plotDefinition.ParametricFunction.Function_x
+			function.Function_y = plotDefinition.ParametricFunction.Function_y
+			function.Min_t = plotDefinition.ParametricFunction.Min_t
+			function.Max_t = plotDefinition.ParametricFunction.Max_t
+
+			//	without an interval for t, the default one is used
+			if function.Min_t == 0 && function.Max_t == 0 {
+				function.Min_t = plot.DEFAULT_MIN_T
+				function.Max_t = plot.DEFAULT_MAX_T
+			}
+
+			plotRequest.Function = append(plotRequest.Function, function)
+		}
+
+		//	add a new vector field sampled in a grid
+		if vectorField {
+
+			field := plotDefinition.VectorField
+
+			set_Points, err := plot.NewVectorField(field.Function_dx, field.Function_dy, field.Min_x, field.Max_x, field.Min_y, field.Max_y, field.Columns, field.Rows)
+			if err != nil {
+				httpResponse.WriteHeader(http.StatusBadRequest)
+				httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+				return
+			}
+
+			if len(plotDefinition.Title) > 0 {
+				set_Points.Title = plotDefinition.Title
+			}
+			set_Points.Line_style = *lineStyle
+			set_Points.Vector = *vector
+			set_Points.Axes = axes
+
+			plotRequest.Set_points = append(plotRequest.Set_points, *set_Points)
+		}
+
+		//	add the contour lines of a function of x and y sampled in a grid
+		if len(plotDefinition.ContourFunction.Function) > 0 {
+
+			contour := plotDefinition.ContourFunction
+
+			set_Points, err := plot.NewContourFunction(contour.Function, contour.Min_x, contour.Max_x, contour.Min_y, contour.Max_y, contour.Columns, contour.Rows)
+			if err != nil {
+				httpResponse.WriteHeader(http.StatusBadRequest)
+				httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "%s" }`, err)))
+				return
+			}
+
+			if len(plotDefinition.Title) > 0 {
+				set_Points.Title = plotDefinition.Title
+			}
+			set_Points.Line_style = *lineStyle
+			set_Points.Axes = axes
+
+			plotRequest.Set_points = append(plotRequest.Set_points, *set_Points)
+		}
+
+		//	add the curves of a relation f(x,y) = g(x,y)
+		if len(plotDefinition.ImplicitFunction.Relation) > 0 {
+
+			implicit := plotDefinition.ImplicitFunction
+
+			if implicit.Min_x >= implicit.Max_x || implicit.Min_y >= implicit.Max_y {
+				httpResponse.WriteHeader(http.StatusBadRequest)
+				httpResponse.Write([]byte(fmt.Sprintf(`{ "error": "implicit function requires the intervals of x and y" }`)))
+				return
+			}
+
+			//	set a default title when necessary
+			title := plotDefinition.Title
+
+			if len(title) == 0 {
+				title = implicit.Relation
+			}
+
+			plotRequest.Function = append(plotRequest.Function, plot.Function_2d{
+				Title:      title,
+				Style:      plot.LINES,
+				Line_style: *lineStyle,
+				Axes:       axes,
+				Function:   implicit.Relation,
+				Min_x:      implicit.Min_x,
+				Max_x:      implicit.Max_x,
+				Min_y:      implicit.Min_y,
+				Max_y:      implicit.Max_y,
+				Implicit:   true,
+			})
+		}
 	}
 
 	//	generate the SVG graphics as a response to HTTP request
@@ -193,3 +633,441 @@ func PlotHandler(httpResponse http.ResponseWriter, httpRequest *http.Request, te
 
 	httpResponse.WriteHeader(http.StatusOK)
 }
+
+//	newTerminalOptions validate the terminal options from the request payload
+func newTerminalOptions(options *terminalOptions, terminal uint8) (*plot.TerminalOptions, error) {
+
+	terminalOptions := &plot.TerminalOptions{
+		Font_family: options.Font_family,
+		Font_size:   options.Font_size,
+	}
+
+	if len(options.Background) > 0 {
+		background, err := plot.ParseColour(options.Background)
+		if err != nil {
+			return nil, errors.New("invalid terminal background: " + err.Error())
+		}
+		terminalOptions.Background = &background
+	}
+
+	if options.Quality != 0 {
+		if terminal != plot.TERMINAL_JPEG {
+			return nil, errors.New("quality option is only valid for jpeg terminal")
+		}
+		if options.Quality < 1 || options.Quality > 100 {
+			return nil, errors.New("jpeg quality expected to be between 1 and 100")
+		}
+		terminalOptions.Quality = options.Quality
+	}
+
+	if options.Dynamic {
+		if terminal != plot.TERMINAL_SVG {
+			return nil, errors.New("dynamic option is only valid for svg terminal")
+		}
+		terminalOptions.Dynamic = true
+	}
+
+	if len(options.Name) > 0 {
+		if terminal != plot.TERMINAL_CANVAS {
+			return nil, errors.New("name option is only valid for canvas terminal")
+		}
+		err := plot.ValidateCanvasName(options.Name)
+		if err != nil {
+			return nil, err
+		}
+		terminalOptions.Name = options.Name
+	}
+
+	return terminalOptions, nil
+}
+
+//	newLineStyle validate the line style from the request payload
+func newLineStyle(style *lineStyle) (*plot.Line_style, error) {
+
+	lineStyle := &plot.Line_style{
+		Width:         style.Width,
+		Dash_type:     style.Dash_type,
+		Point_type:    style.Point_type,
+		Point_size:    style.Point_size,
+		Variable_size: style.Variable_size,
+	}
+
+	if len(style.Colour) > 0 {
+		colour, err := plot.ParseColour(style.Colour)
+		if err != nil {
+			return nil, errors.New("invalid line colour: " + err.Error())
+		}
+		lineStyle.Colour = &colour
+	}
+
+	if len(style.Colour_source) > 0 {
+		var found bool
+
+		lineStyle.Colour_source, found = plot.Colour_source[style.Colour_source]
+		if !found {
+			return nil, errors.New("invalid colour source: " + style.Colour_source)
+		}
+	}
+
+	if style.Width < 0 {
+		return nil, errors.New("line width expected to be positive")
+	}
+	if style.Dash_type > plot.DASH_DOT_DOT {
+		return nil, errors.New("invalid dash type")
+	}
+	if style.Point_size < 0 {
+		return nil, errors.New("point size expected to be positive")
+	}
+
+	return lineStyle, nil
+}
+
+//	newFillStyle validate the fill style from the request payload (a full solid fill when not informed)
+func newFillStyle(style *fillStyle) (*plot.Fill_style, error) {
+
+	fill := &plot.Fill_style{}
+	if style == nil {
+		return fill, nil
+	}
+
+	if len(style.Type) > 0 {
+		var found bool
+
+		fill.Type, found = plot.Fill_type[style.Type]
+		if !found {
+			return nil, errors.New("invalid fill type: " + style.Type)
+		}
+	}
+
+	if style.Density < 0 || style.Density > 1 {
+		return nil, errors.New("fill density expected to be between 0 and 1")
+	}
+	if style.Pattern >= plot.PATTERN_COUNT {
+		return nil, errors.New("invalid fill pattern")
+	}
+
+	fill.Density = style.Density
+	fill.Pattern = style.Pattern
+	fill.Transparent = style.Transparent
+
+	return fill, nil
+}
+
+//	newVectorStyle validate the heads of vectors from the request payload
+func newVectorStyle(style *vectorStyle) (*plot.Vector_style, error) {
+
+	vector := &plot.Vector_style{
+		Head_length: style.Head_length,
+		Head_angle:  style.Head_angle,
+	}
+
+	if len(style.Head) > 0 {
+		var found bool
+
+		vector.Head, found = plot.Arrow_head[style.Head]
+		if !found {
+			return nil, errors.New("invalid vector head: " + style.Head)
+		}
+	}
+
+	if style.Head_length < 0 || style.Head_angle < 0 {
+		return nil, errors.New("vector head size expected to be positive")
+	}
+
+	return vector, nil
+}
+
+//	newAnnotation validate an annotation from the request payload
+func newAnnotation(definition *annotationDefinition) (*plot.Annotation, error) {
+
+	var found bool
+
+	annotation := &plot.Annotation{
+		Text:        definition.Text,
+		Angle:       definition.Angle,
+		Font_family: definition.Font_family,
+		Font_size:   definition.Font_size,
+		No_border:   definition.No_border,
+	}
+
+	annotation.Type, found = plot.Annotation_type[definition.Type]
+	if !found {
+		return nil, errors.New("invalid annotation type: " + definition.Type)
+	}
+
+	//	convert every position and the size
+	for _, position := range definition.Position {
+		coordinate, err := newCoordinate(&position)
+		if err != nil {
+			return nil, err
+		}
+		annotation.Position = append(annotation.Position, *coordinate)
+	}
+
+	size, err := newCoordinate(&definition.Size)
+	if err != nil {
+		return nil, err
+	}
+	annotation.Size = *size
+
+	if len(definition.Justification) > 0 {
+		annotation.Justification, found = plot.Justification[definition.Justification]
+		if !found {
+			return nil, errors.New("invalid justification: " + definition.Justification)
+		}
+	}
+
+	if len(definition.Head) > 0 {
+		annotation.Head, found = plot.Arrow_head[definition.Head]
+		if !found {
+			return nil, errors.New("invalid arrow head: " + definition.Head)
+		}
+	}
+
+	lineStyle, err := newLineStyle(&definition.Line_style)
+	if err != nil {
+		return nil, err
+	}
+	annotation.Line_style = *lineStyle
+
+	if len(definition.Fill_colour) > 0 {
+		fillColour, err := plot.ParseColour(definition.Fill_colour)
+		if err != nil {
+			return nil, errors.New("invalid fill colour: " + err.Error())
+		}
+		annotation.Fill_colour = &fillColour
+	}
+
+	if definition.Front != nil {
+		annotation.Layer = plot.LAYER_BACK
+		if *definition.Front {
+			annotation.Layer = plot.LAYER_FRONT
+		}
+	}
+
+	err = annotation.Validate()
+	if err != nil {
+		return nil, errors.New(err.Error() + ": " + definition.Type)
+	}
+
+	return annotation, nil
+}
+
+//	addReferences validate the zero axes, reference lines and bands from the request payload
+func addReferences(plotRequest *plot.Plot_2D, requestData *plot2DRequest) error {
+
+	var found bool
+
+	if requestData.X_zero_axis != nil {
+		lineStyle, err := newLineStyle(requestData.X_zero_axis)
+		if err != nil {
+			return err
+		}
+		plotRequest.X_zero_axis = lineStyle
+	}
+
+	if requestData.Y_zero_axis != nil {
+		lineStyle, err := newLineStyle(requestData.Y_zero_axis)
+		if err != nil {
+			return err
+		}
+		plotRequest.Y_zero_axis = lineStyle
+	}
+
+	for _, line := range requestData.Reference_lines {
+		reference := plot.Reference_line{
+			Value: line.Value,
+			Title: line.Title,
+		}
+
+		reference.Orientation, found = plot.Orientation[line.Orientation]
+		if !found {
+			return errors.New("invalid reference line orientation: " + line.Orientation)
+		}
+
+		lineStyle, err := newLineStyle(&line.Line_style)
+		if err != nil {
+			return err
+		}
+		reference.Line_style = *lineStyle
+
+		plotRequest.Reference_lines = append(plotRequest.Reference_lines, reference)
+	}
+
+	for _, band := range requestData.Reference_bands {
+		reference := plot.Reference_band{
+			From:  band.From,
+			To:    band.To,
+			Title: band.Title,
+		}
+
+		reference.Orientation, found = plot.Orientation[band.Orientation]
+		if !found {
+			return errors.New("invalid reference band orientation: " + band.Orientation)
+		}
+
+		if len(band.Colour) > 0 {
+			colour, err := plot.ParseColour(band.Colour)
+			if err != nil {
+				return errors.New("invalid band colour: " + err.Error())
+			}
+			reference.Colour = &colour
+		}
+
+		plotRequest.Reference_bands = append(plotRequest.Reference_bands, reference)
+	}
+
+	return nil
+}
+
+//	addStyles validate the layout of histograms, the colours of candlesticks, the whiskers of boxplots, the palette,
+//	the levels of contour lines and the labels of the x axis, placed at the rows 0, 1, 2, ...
+func addStyles(plotRequest *plot.Plot_2D, requestData *plot2DRequest) error {
+
+	if requestData.Histogram != nil {
+		if len(requestData.Histogram.Layout) > 0 {
+			var found bool
+
+			plotRequest.Histogram.Layout, found = plot.Histogram_layout[requestData.Histogram.Layout]
+			if !found {
+				return errors.New("invalid histogram layout: " + requestData.Histogram.Layout)
+			}
+		}
+
+		if requestData.Histogram.Gap != nil {
+			if *requestData.Histogram.Gap < 0 {
+				return errors.New("histogram gap expected to be positive")
+			}
+			plotRequest.Histogram.Gap = *requestData.Histogram.Gap
+		}
+	}
+
+	if requestData.Candlesticks != nil {
+		if len(requestData.Candlesticks.Rising) > 0 {
+			colour, err := plot.ParseColour(requestData.Candlesticks.Rising)
+			if err != nil {
+				return errors.New("invalid rising colour: " + err.Error())
+			}
+			plotRequest.Financial.Rising = &colour
+		}
+
+		if len(requestData.Candlesticks.Falling) > 0 {
+			colour, err := plot.ParseColour(requestData.Candlesticks.Falling)
+			if err != nil {
+				return errors.New("invalid falling colour: " + err.Error())
+			}
+			plotRequest.Financial.Falling = &colour
+		}
+	}
+
+	if requestData.Boxplot != nil {
+		if requestData.Boxplot.Range < 0 {
+			return errors.New("boxplot range expected to be non-negative")
+		}
+		plotRequest.Boxplot = plot.Boxplot_style{
+			Range:       requestData.Boxplot.Range,
+			No_outliers: requestData.Boxplot.No_outliers,
+		}
+	}
+
+	for _, colour := range requestData.Palette {
+		paletteColour, err := plot.ParseColour(colour.Colour)
+		if err != nil {
+			return errors.New("invalid palette colour: " + err.Error())
+		}
+		plotRequest.Palette.Defined = append(plotRequest.Palette.Defined, plot.Palette_colour{Value: colour.Value, Colour: paletteColour})
+	}
+
+	err := plotRequest.Palette.Validate()
+	if err != nil {
+		return err
+	}
+	plotRequest.No_colour_box = requestData.No_colour_box
+
+	if requestData.Contour != nil {
+		var found bool
+
+		plotRequest.Contour = plot.Contour_style{
+			Levels: plot.Contour_levels{
+				Count:     requestData.Contour.Count,
+				Values:    requestData.Contour.Values,
+				Start:     requestData.Contour.Start,
+				Increment: requestData.Contour.Increment,
+				End:       requestData.Contour.End,
+			},
+			No_labels: requestData.Contour.No_labels,
+		}
+
+		if len(requestData.Contour.Levels) > 0 {
+			plotRequest.Contour.Levels.Kind, found = plot.Contour_levels_kind[requestData.Contour.Levels]
+			if !found {
+				return errors.New("invalid contour levels: " + requestData.Contour.Levels)
+			}
+		}
+
+		err = plotRequest.Contour.Levels.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, label := range requestData.X_tic_labels {
+		plotRequest.X_axis.Tic_labels = append(plotRequest.X_axis.Tic_labels, plot.Tic_label{Label: label, Value: float64(i)})
+	}
+
+	return nil
+}
+
+//	newCoordinate validate a coordinate from the request payload
+func newCoordinate(position *coordinate) (*plot.Coordinate, error) {
+
+	var found bool
+
+	coordinate := &plot.Coordinate{
+		X: position.X,
+		Y: position.Y,
+	}
+
+	if len(position.X_system) > 0 {
+		coordinate.X_system, found = plot.Coordinate_system[position.X_system]
+		if !found {
+			return nil, errors.New("invalid coordinate system: " + position.X_system)
+		}
+	}
+
+	//	the y component uses the coordinate system of the x one when it's not informed
+	coordinate.Y_system = coordinate.X_system
+
+	if len(position.Y_system) > 0 {
+		coordinate.Y_system, found = plot.Coordinate_system[position.Y_system]
+		if !found {
+			return nil, errors.New("invalid coordinate system: " + position.Y_system)
+		}
+	}
+
+	return coordinate, nil
+}
+
+//	errorExtents return the error extents of a point, when any of them is informed
+func (point *plotPoint) errorExtents() *plot.Error_2d {
+	if point.X_low == nil && point.X_high == nil && point.Y_low == nil && point.Y_high == nil {
+		return nil
+	}
+
+	extents := &plot.Error_2d{X_low: point.X, X_high: point.X, Y_low: point.Y, Y_high: point.Y}
+
+	if point.X_low != nil {
+		extents.X_low = *point.X_low
+	}
+	if point.X_high != nil {
+		extents.X_high = *point.X_high
+	}
+	if point.Y_low != nil {
+		extents.Y_low = *point.Y_low
+	}
+	if point.Y_high != nil {
+		extents.Y_high = *point.Y_high
+	}
+
+	return extents
+}
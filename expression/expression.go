@@ -8,6 +8,7 @@ package expression
 
 import (
 	"errors"
+	"math"
 	"strconv"
 )
 
@@ -49,6 +50,8 @@ const (
 	OPEN_PARENTHESIS  uint8 = 8
 	CLOSE_PARENTHESIS uint8 = 9
 	EMPTY             uint8 = 10
+	POWER_OPERATOR    uint8 = 11
+	NEGATION_OPERATOR uint8 = 12
 )
 
 type token struct {
@@ -62,8 +65,20 @@ func lexicalAnalizer(expression string) ([]token, error) {
 	var tokenList []token = make([]token, 0)
 	var identifier string
 	var literal string
+	var previous rune
 
 	for _, char := range expression {
+		//	two consecutive asterisks are the power operator
+		if char == '*' && previous == '*' && tokenList[len(tokenList)-1].category == TIMES_OPERATOR {
+			tokenList[len(tokenList)-1] = token{
+				category: POWER_OPERATOR,
+				value:    "**",
+			}
+			previous = 0
+			continue
+		}
+		previous = char
+
 		switch char {
 		//	a space after a valid token means the previous token have to be appended to the list
 		case ' ':
@@ -219,6 +234,7 @@ const (
 	FACTOR          uint8 = 105
 	TERM_LINE       uint8 = 106
 	PARAMETER_LIST  uint8 = 107
+	POWER_LINE      uint8 = 108
 )
 
 //	Context free grammar entry
@@ -239,10 +255,13 @@ var expressionGrammar []grammarEntry = []grammarEntry{
 	{symbol: TERM_LINE, derives: []uint8{TIMES_OPERATOR, FACTOR, TERM_LINE}, tokensWanted: 1},
 	{symbol: TERM_LINE, derives: []uint8{DIV_OPERATOR, FACTOR, TERM_LINE}, tokensWanted: 1},
 	{symbol: TERM_LINE, derives: []uint8{EMPTY}},
-	{symbol: FACTOR, derives: []uint8{NAME, OPEN_PARENTHESIS, PARAMETER_LIST, CLOSE_PARENTHESIS}, tokensWanted: 2},
-	{symbol: FACTOR, derives: []uint8{OPEN_PARENTHESIS, EXPRESSION, CLOSE_PARENTHESIS}, tokensWanted: 1},
-	{symbol: FACTOR, derives: []uint8{LITERAL}, tokensWanted: 1},
-	{symbol: FACTOR, derives: []uint8{NAME}, tokensWanted: 1},
+	{symbol: FACTOR, derives: []uint8{NAME, OPEN_PARENTHESIS, PARAMETER_LIST, CLOSE_PARENTHESIS, POWER_LINE}, tokensWanted: 2},
+	{symbol: FACTOR, derives: []uint8{OPEN_PARENTHESIS, EXPRESSION, CLOSE_PARENTHESIS, POWER_LINE}, tokensWanted: 1},
+	{symbol: FACTOR, derives: []uint8{LITERAL, POWER_LINE}, tokensWanted: 1},
+	{symbol: FACTOR, derives: []uint8{NAME, POWER_LINE}, tokensWanted: 1},
+	{symbol: FACTOR, derives: []uint8{SUB_OPERATOR, FACTOR}, tokensWanted: 1},
+	{symbol: POWER_LINE, derives: []uint8{POWER_OPERATOR, FACTOR}, tokensWanted: 1},
+	{symbol: POWER_LINE, derives: []uint8{EMPTY}},
 	//	TODO: change the grammar to support multiple parameters separeted by commas
 	{symbol: PARAMETER_LIST, derives: []uint8{EXPRESSION}},
 	//	TODO: change the grammar to support function calls witout parameters
@@ -297,16 +316,20 @@ func expressionParser(tokenList []token) (Queue, error) {
 			}
 
 			if searchNode.inputToken.category == ADD_OPERATOR || searchNode.inputToken.category == SUB_OPERATOR ||
-				searchNode.inputToken.category == TIMES_OPERATOR || searchNode.inputToken.category == DIV_OPERATOR {
+				searchNode.inputToken.category == TIMES_OPERATOR || searchNode.inputToken.category == DIV_OPERATOR ||
+				searchNode.inputToken.category == POWER_OPERATOR || searchNode.inputToken.category == NEGATION_OPERATOR {
 
 				postfix.Put(searchNode.inputToken)
 			}
 			continue
 		}
 
-		//	insert left node, itself, and the right
+		//	insert left node, itself, and the right (unary operators are placed after their operand)
 		if len(searchNode.childNodes) == 1 {
 			treeSearch.Push(searchNode.childNodes[0])
+		} else if len(searchNode.childNodes) == 2 {
+			treeSearch.Push(searchNode.childNodes[0])
+			treeSearch.Push(searchNode.childNodes[1])
 		} else {
 			if len(searchNode.childNodes) == 3 {
 				treeSearch.Push(searchNode.childNodes[1])
@@ -649,22 +672,81 @@ func createSyntaxTree(parsingTree *syntaxNode) (*syntaxNode, error) {
 			}
 
 		case FACTOR:
-			if len(searchNode.childNodes) == 1 {
+			//	a factor raised to a power is an operation between the factor and the exponent
+			childNodes := searchNode.childNodes
+			powerLine := childNodes[len(childNodes)-1]
+
+			if powerLine.grammarItem == POWER_LINE {
+				childNodes = childNodes[:len(childNodes)-1]
+
+				if powerLine.childNodes[0].grammarItem != EMPTY {
+					currentNode.childNodes = make([]*syntaxNode, 3)
+
+					currentNode.childNodes[0] = &syntaxNode{
+						grammarItem: FACTOR,
+						childNodes:  nil,
+						inputToken:  nil,
+					}
+					currentNode.childNodes[1] = &syntaxNode{
+						grammarItem: POWER_OPERATOR,
+						childNodes:  nil,
+						inputToken:  powerLine.childNodes[0].inputToken,
+					}
+					currentNode.childNodes[2] = &syntaxNode{
+						grammarItem: FACTOR,
+						childNodes:  nil,
+						inputToken:  nil,
+					}
+
+					parsingTreeSearch.Push(&syntaxNode{
+						grammarItem: FACTOR,
+						childNodes:  childNodes,
+						inputToken:  nil,
+					})
+					syntaxNodeSearch.Push(currentNode.childNodes[0])
+
+					parsingTreeSearch.Push(powerLine.childNodes[1])
+					syntaxNodeSearch.Push(currentNode.childNodes[2])
+					break
+				}
+			}
+
+			if len(childNodes) == 2 {
+				//	the unary minus is the negation of the factor
+				currentNode.childNodes = make([]*syntaxNode, 2)
+
+				currentNode.childNodes[0] = &syntaxNode{
+					grammarItem: NEGATION_OPERATOR,
+					childNodes:  nil,
+					inputToken:  childNodes[0].inputToken,
+				}
+				currentNode.childNodes[1] = &syntaxNode{
+					grammarItem: FACTOR,
+					childNodes:  nil,
+					inputToken:  nil,
+				}
+
+				//	change token category to Negation Operator
+				currentNode.childNodes[0].inputToken.category = NEGATION_OPERATOR
+
+				parsingTreeSearch.Push(childNodes[1])
+				syntaxNodeSearch.Push(currentNode.childNodes[1])
+			} else if len(childNodes) == 1 {
 				currentNode.childNodes = make([]*syntaxNode, 1)
 
 				currentNode.childNodes[0] = &syntaxNode{
-					grammarItem: searchNode.childNodes[0].grammarItem,
+					grammarItem: childNodes[0].grammarItem,
 					childNodes:  nil,
-					inputToken:  searchNode.childNodes[0].inputToken,
+					inputToken:  childNodes[0].inputToken,
 				}
 			} else {
-				if len(searchNode.childNodes) == 3 {
+				if len(childNodes) == 3 {
 					currentNode.childNodes = make([]*syntaxNode, 3)
 
 					currentNode.childNodes[0] = &syntaxNode{
 						grammarItem: OPEN_PARENTHESIS,
 						childNodes:  nil,
-						inputToken:  searchNode.childNodes[0].inputToken,
+						inputToken:  childNodes[0].inputToken,
 					}
 					currentNode.childNodes[1] = &syntaxNode{
 						grammarItem: EXPRESSION,
@@ -674,10 +756,10 @@ func createSyntaxTree(parsingTree *syntaxNode) (*syntaxNode, error) {
 					currentNode.childNodes[2] = &syntaxNode{
 						grammarItem: CLOSE_PARENTHESIS,
 						childNodes:  nil,
-						inputToken:  searchNode.childNodes[2].inputToken,
+						inputToken:  childNodes[2].inputToken,
 					}
 
-					parsingTreeSearch.Push(searchNode.childNodes[1])
+					parsingTreeSearch.Push(childNodes[1])
 					syntaxNodeSearch.Push(currentNode.childNodes[1])
 				} else {
 					currentNode.childNodes = make([]*syntaxNode, 4)
@@ -685,12 +767,12 @@ func createSyntaxTree(parsingTree *syntaxNode) (*syntaxNode, error) {
 					currentNode.childNodes[0] = &syntaxNode{
 						grammarItem: FUNCTION_NAME,
 						childNodes:  nil,
-						inputToken:  searchNode.childNodes[0].inputToken,
+						inputToken:  childNodes[0].inputToken,
 					}
 					currentNode.childNodes[1] = &syntaxNode{
 						grammarItem: OPEN_PARENTHESIS,
 						childNodes:  nil,
-						inputToken:  searchNode.childNodes[1].inputToken,
+						inputToken:  childNodes[1].inputToken,
 					}
 					currentNode.childNodes[2] = &syntaxNode{
 						grammarItem: PARAMETER_LIST,
@@ -700,13 +782,13 @@ func createSyntaxTree(parsingTree *syntaxNode) (*syntaxNode, error) {
 					currentNode.childNodes[3] = &syntaxNode{
 						grammarItem: CLOSE_PARENTHESIS,
 						childNodes:  nil,
-						inputToken:  searchNode.childNodes[3].inputToken,
+						inputToken:  childNodes[3].inputToken,
 					}
 
 					//	change token category to Function Name
 					currentNode.childNodes[0].inputToken.category = FUNCTION_NAME
 
-					parsingTreeSearch.Push(searchNode.childNodes[2])
+					parsingTreeSearch.Push(childNodes[2])
 					syntaxNodeSearch.Push(currentNode.childNodes[2])
 				}
 			}
@@ -727,9 +809,44 @@ func createSyntaxTree(parsingTree *syntaxNode) (*syntaxNode, error) {
 		}
 	}
 
+	leftAssociative(syntaxTree)
+
 	return syntaxTree, nil
 }
 
+//	leftAssociative rearrange the operations of the syntax tree, where the right operand of an operation is the rest
+//	of the expression or term, to evaluate the subtractions and divisions from left to right
+func leftAssociative(node *syntaxNode) {
+
+	if node == nil {
+		return
+	}
+
+	for len(node.childNodes) == 3 && node.childNodes[1].inputToken != nil &&
+		(node.childNodes[1].inputToken.category == SUB_OPERATOR || node.childNodes[1].inputToken.category == DIV_OPERATOR) {
+
+		right := node.childNodes[2]
+		if right.grammarItem != node.grammarItem || len(right.childNodes) != 3 {
+			break
+		}
+
+		//	a - (b op c) is replaced by (a - b) op c
+		node.childNodes = []*syntaxNode{
+			{
+				grammarItem: node.grammarItem,
+				childNodes:  []*syntaxNode{node.childNodes[0], node.childNodes[1], right.childNodes[0]},
+				inputToken:  nil,
+			},
+			right.childNodes[1],
+			right.childNodes[2],
+		}
+	}
+
+	for _, child := range node.childNodes {
+		leftAssociative(child)
+	}
+}
+
 //	evaluatePolishReverse evaluate the Polish reverse expression (postfix) and return a numerical result
 func (p *ParsedExpression) Evaluate(symbol SymbolTable) (float64, error) {
 
@@ -782,6 +899,17 @@ func (p *ParsedExpression) Evaluate(symbol SymbolTable) (float64, error) {
 			continue
 		}
 
+		//	check if current token is the unary minus
+		if currentToken.category == NEGATION_OPERATOR {
+
+			if operand.IsEmpty() {
+				return 0, errors.New("syntax error: negation requires an operand")
+			}
+			operand.Push(-operand.Pop().(float64))
+
+			continue
+		}
+
 		//	must be a basic operation
 		var operand1 float64
 		var operand2 float64
@@ -808,6 +936,9 @@ func (p *ParsedExpression) Evaluate(symbol SymbolTable) (float64, error) {
 
 		case DIV_OPERATOR:
 			operand.Push(operand1 / operand2)
+
+		case POWER_OPERATOR:
+			operand.Push(math.Pow(operand1, operand2))
 		}
 	}
 
@@ -34,6 +34,7 @@ func Test_lexicalAnalizer(t *testing.T) {
 		{scenario: "using a variable name with underscore", input: "var_x+2", output: []string{"var_x", "+", "2"}},
 		{scenario: "using a function call", input: "sin(x)", output: []string{"sin", "(", "x", ")"}},
 		{scenario: "expression with a function call", input: "x*sin(2*x)", output: []string{"x", "*", "sin", "(", "2", "*", "x", ")"}},
+		{scenario: "power operator", input: "x**2 + y ** 2", output: []string{"x", "**", "2", "+", "y", "**", "2"}},
 	}
 
 	t.Run(">>> test tokens found by lexical analizer", func(t *testing.T) {
@@ -127,7 +128,7 @@ func Test_expressionParser(t *testing.T) {
 			{category: NAME, value: "x"},
 			{category: ADD_OPERATOR, value: "+"},
 			{category: LITERAL, value: "2"},
-		}, output: "x x * 3 x * 2 + -"},
+		}, output: "x x * 3 x * - 2 +"},
 		{scenario: "one parenthesis", input: []token{
 			{category: OPEN_PARENTHESIS, value: "("},
 			{category: LITERAL, value: "4"},
@@ -164,7 +165,7 @@ func Test_expressionParser(t *testing.T) {
 			{category: CLOSE_PARENTHESIS, value: ")"},
 			{category: ADD_OPERATOR, value: "+"},
 			{category: LITERAL, value: "2"},
-		}, output: "x x * 3 x * 2 + -"},
+		}, output: "x x * 3 x * - 2 +"},
 		{scenario: "using a variable name", input: []token{
 			{category: NAME, value: "x"},
 			{category: ADD_OPERATOR, value: "+"},
@@ -187,6 +188,47 @@ func Test_expressionParser(t *testing.T) {
 			{category: NAME, value: "x"},
 			{category: CLOSE_PARENTHESIS, value: ")"},
 		}, output: "x sin"},
+		{scenario: "subtractions and divisions from left to right", input: []token{
+			{category: LITERAL, value: "5"},
+			{category: SUB_OPERATOR, value: "-"},
+			{category: LITERAL, value: "3"},
+			{category: SUB_OPERATOR, value: "-"},
+			{category: LITERAL, value: "8"},
+			{category: DIV_OPERATOR, value: "/"},
+			{category: LITERAL, value: "2"},
+			{category: DIV_OPERATOR, value: "/"},
+			{category: LITERAL, value: "2"},
+		}, output: "5 3 - 8 2 / 2 / -"},
+		{scenario: "power operator", input: []token{
+			{category: NAME, value: "x"},
+			{category: POWER_OPERATOR, value: "**"},
+			{category: LITERAL, value: "2"},
+			{category: ADD_OPERATOR, value: "+"},
+			{category: NAME, value: "y"},
+			{category: POWER_OPERATOR, value: "**"},
+			{category: LITERAL, value: "2"},
+		}, output: "x 2 ** y 2 ** +"},
+		{scenario: "power of a function call and of a power", input: []token{
+			{category: NAME, value: "sin"},
+			{category: OPEN_PARENTHESIS, value: "("},
+			{category: NAME, value: "x"},
+			{category: CLOSE_PARENTHESIS, value: ")"},
+			{category: POWER_OPERATOR, value: "**"},
+			{category: LITERAL, value: "3"},
+			{category: POWER_OPERATOR, value: "**"},
+			{category: LITERAL, value: "2"},
+		}, output: "x sin 3 2 ** **"},
+		{scenario: "unary minus", input: []token{
+			{category: SUB_OPERATOR, value: "-"},
+			{category: NAME, value: "x"},
+			{category: POWER_OPERATOR, value: "**"},
+			{category: LITERAL, value: "2"},
+			{category: TIMES_OPERATOR, value: "*"},
+			{category: SUB_OPERATOR, value: "-"},
+			{category: OPEN_PARENTHESIS, value: "("},
+			{category: NAME, value: "x"},
+			{category: CLOSE_PARENTHESIS, value: ")"},
+		}, output: "x 2 ** - x - *"},
 
 		//	syntax error expressions scenarios
 		{scenario: "operation without an operator", input: []token{
@@ -677,6 +719,15 @@ func Test_evaluatePolishReverse(t *testing.T) {
 			{category: NAME, value: "x"},
 			{category: DIV_OPERATOR, value: "/"},
 		}, x_value: 2, output: 5},
+		{scenario: "x to a power", input: []token{
+			{category: NAME, value: "x"},
+			{category: LITERAL, value: "3"},
+			{category: POWER_OPERATOR, value: "**"},
+		}, x_value: 2, output: 8},
+		{scenario: "negation of x", input: []token{
+			{category: NAME, value: "x"},
+			{category: NEGATION_OPERATOR, value: "-"},
+		}, x_value: 2, output: -2},
 	}
 
 	t.Run(">>> test Polish Reverse evaluation", func(t *testing.T) {
@@ -717,3 +768,51 @@ func Test_evaluatePolishReverse(t *testing.T) {
 		}
 	})
 }
+
+//	Test_NewExpression test cases for the evaluation of parsed expressions
+func Test_NewExpression(t *testing.T) {
+
+	//	a few test cases
+	var testScenarios = []struct {
+		scenario string
+		input    string
+		x_value  float64
+		output   float64
+	}{
+		{scenario: "subtraction followed by addition", input: "x*x-3*x+2", x_value: 4, output: 6},
+		{scenario: "chain of subtractions", input: "10-4-3", x_value: 0, output: 3},
+		{scenario: "chain of divisions", input: "8/2/2", x_value: 0, output: 2},
+		{scenario: "power is right associative", input: "2**3**2", x_value: 0, output: 512},
+		{scenario: "power before unary minus", input: "-x**2", x_value: 3, output: -9},
+		{scenario: "unary minus in a product", input: "2*-x", x_value: 3, output: -6},
+	}
+
+	t.Run(">>> test parsing and evaluation of expressions", func(t *testing.T) {
+
+		for _, test := range testScenarios {
+
+			expr, err := NewExpression(test.input)
+			if err != nil {
+				t.Errorf("unexpected error parsing %s: %s", test.scenario, err)
+				continue
+			}
+
+			//	create the symbol table
+			symbolTable := NewFloatSymbolTable()
+
+			symbolTable.SetValue("x", test.x_value)
+
+			want := test.output
+			got, err := expr.Evaluate(symbolTable)
+			if err != nil {
+				t.Errorf("unexpected error evaluating %s: %s", test.scenario, err)
+				continue
+			}
+
+			//	check the result
+			if want != got {
+				t.Errorf("failed evaluating %s: expected: %f result: %f", test.scenario, want, got)
+			}
+		}
+	})
+}
@@ -134,3 +134,44 @@ func AddStandardMathFuncs(s SymbolTable) {
 		return math.Tan(x[0])
 	}, 1)
 }
+
+//	units of angles used by trigonometric functions
+const (
+	RADIANS uint8 = 0
+	DEGREES uint8 = 1
+)
+
+//	SetAngleUnit redefine the trigonometric functions of the symbol table to use the unit of angles
+func SetAngleUnit(s SymbolTable, unit uint8) {
+
+	//	factor to convert from the unit of angles to radians
+	factor := 1.0
+
+	if unit == DEGREES {
+		factor = math.Pi / 180
+	}
+
+	s.DefineFunc("acos", func(x ...float64) float64 {
+		return math.Acos(x[0]) / factor
+	}, 1)
+
+	s.DefineFunc("asin", func(x ...float64) float64 {
+		return math.Asin(x[0]) / factor
+	}, 1)
+
+	s.DefineFunc("atan", func(x ...float64) float64 {
+		return math.Atan(x[0]) / factor
+	}, 1)
+
+	s.DefineFunc("cos", func(x ...float64) float64 {
+		return math.Cos(x[0] * factor)
+	}, 1)
+
+	s.DefineFunc("sin", func(x ...float64) float64 {
+		return math.Sin(x[0] * factor)
+	}, 1)
+
+	s.DefineFunc("tan", func(x ...float64) float64 {
+		return math.Tan(x[0] * factor)
+	}, 1)
+}
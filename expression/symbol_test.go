@@ -166,4 +166,38 @@ func Test_FunctionTable(t *testing.T) {
 			t.Errorf("fail in symbol table InvokeFunc: expected: %f result: %f", wantFloat, gotFloat)
 		}
 	})
+
+	t.Run(">>> test the symbol table SetAngleUnit() func", func(t *testing.T) {
+
+		symbolTable := NewFloatSymbolTable()
+		AddStandardMathFuncs(symbolTable)
+
+		//	trigonometric functions in degrees
+		fmt.Printf("scenario: trigonometric functions in degrees\n")
+
+		SetAngleUnit(symbolTable, DEGREES)
+
+		wantFloat := 1.0
+		gotFloat, _ := symbolTable.InvokeFunc("sin", 90)
+		if math.Abs(gotFloat-wantFloat) > 1e-12 {
+			t.Errorf("fail in symbol table SetAngleUnit: expected: %f result: %f", wantFloat, gotFloat)
+		}
+
+		wantFloat = 45.0
+		gotFloat, _ = symbolTable.InvokeFunc("atan", 1)
+		if math.Abs(gotFloat-wantFloat) > 1e-12 {
+			t.Errorf("fail in symbol table SetAngleUnit: expected: %f result: %f", wantFloat, gotFloat)
+		}
+
+		//	trigonometric functions back in radians
+		fmt.Printf("scenario: trigonometric functions in radians\n")
+
+		SetAngleUnit(symbolTable, RADIANS)
+
+		wantFloat = math.Cos(math.Pi)
+		gotFloat, _ = symbolTable.InvokeFunc("cos", math.Pi)
+		if gotFloat != wantFloat {
+			t.Errorf("fail in symbol table SetAngleUnit: expected: %f result: %f", wantFloat, gotFloat)
+		}
+	})
 }
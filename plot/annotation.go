@@ -0,0 +1,422 @@
+////////////////////////////////////////////////////////////////////////////////
+//	annotation.go  -  Oct-19-2026  -  aldebap
+//
+//	Text labels, arrows and shape objects drawn over a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+)
+
+//	types of annotations
+const (
+	ANNOTATION_LABEL     uint8 = 1
+	ANNOTATION_ARROW     uint8 = 2
+	ANNOTATION_RECTANGLE uint8 = 3
+	ANNOTATION_CIRCLE    uint8 = 4
+	ANNOTATION_ELLIPSE   uint8 = 5
+	ANNOTATION_POLYGON   uint8 = 6
+)
+
+//	coordinate systems (first is the default one)
+const (
+	COORDINATES_FIRST  uint8 = 0
+	COORDINATES_SECOND uint8 = 1
+	COORDINATES_GRAPH  uint8 = 2
+	COORDINATES_SCREEN uint8 = 3
+)
+
+//	justification of labels
+const (
+	JUSTIFY_LEFT   uint8 = 0
+	JUSTIFY_CENTER uint8 = 1
+	JUSTIFY_RIGHT  uint8 = 2
+)
+
+//	heads of arrows
+const (
+	ARROW_HEAD     uint8 = 0
+	ARROW_NOHEAD   uint8 = 1
+	ARROW_HEADS    uint8 = 2
+	ARROW_BACKHEAD uint8 = 3
+)
+
+//	layers where annotations are drawn (by default objects are drawn behind the data)
+const (
+	LAYER_DEFAULT uint8 = 0
+	LAYER_BACK    uint8 = 1
+	LAYER_FRONT   uint8 = 2
+)
+
+const (
+	ARROW_HEAD_LENGTH = 10
+	ARROW_HEAD_ANGLE  = math.Pi / 10
+	ELLIPSE_VERTICES  = 72
+)
+
+//	descriptions used to parse annotations
+var (
+	Annotation_type = map[string]uint8{
+		"label":   ANNOTATION_LABEL,
+		"arrow":   ANNOTATION_ARROW,
+		"rect":    ANNOTATION_RECTANGLE,
+		"circle":  ANNOTATION_CIRCLE,
+		"ellipse": ANNOTATION_ELLIPSE,
+		"polygon": ANNOTATION_POLYGON,
+	}
+
+	Coordinate_system = map[string]uint8{
+		"first":  COORDINATES_FIRST,
+		"second": COORDINATES_SECOND,
+		"graph":  COORDINATES_GRAPH,
+		"screen": COORDINATES_SCREEN,
+	}
+
+	Justification = map[string]uint8{
+		"left":   JUSTIFY_LEFT,
+		"center": JUSTIFY_CENTER,
+		"right":  JUSTIFY_RIGHT,
+	}
+
+	Arrow_head = map[string]uint8{
+		"head":     ARROW_HEAD,
+		"nohead":   ARROW_NOHEAD,
+		"heads":    ARROW_HEADS,
+		"backhead": ARROW_BACKHEAD,
+	}
+)
+
+//	coordinate of an annotation, where each component has it's own coordinate system
+type Coordinate struct {
+	X        float64
+	Y        float64
+	X_system uint8
+	Y_system uint8
+}
+
+//	text label, arrow or shape object drawn over the plot
+type Annotation struct {
+	Type          uint8
+	Tag           int
+	Text          string
+	Position      []Coordinate
+	Size          Coordinate
+	Angle         float64
+	Justification uint8
+	Font_family   string
+	Font_size     uint8
+	Head          uint8
+	Line_style    Line_style
+	Fill_colour   *RGB_colour
+	No_border     bool
+	Layer         uint8
+}
+
+//	front check if the annotation must be drawn in front of the data
+func (annotation *Annotation) front() bool {
+	switch annotation.Layer {
+	case LAYER_BACK:
+		return false
+
+	case LAYER_FRONT:
+		return true
+	}
+
+	return annotation.Type == ANNOTATION_LABEL || annotation.Type == ANNOTATION_ARROW
+}
+
+//	Validate check if the annotation has the positions required by it's type
+func (annotation *Annotation) Validate() error {
+
+	required := 1
+
+	switch annotation.Type {
+	case ANNOTATION_LABEL, ANNOTATION_CIRCLE, ANNOTATION_ELLIPSE:
+
+	case ANNOTATION_ARROW, ANNOTATION_RECTANGLE:
+		required = 2
+
+	case ANNOTATION_POLYGON:
+		required = 3
+
+	default:
+		return errors.New("invalid annotation type")
+	}
+
+	if len(annotation.Position) < required {
+		return errors.New("not enough positions for the annotation")
+	}
+
+	return nil
+}
+
+//	annotationFrame dimensions used to convert annotation coordinates into driver coordinates
+type annotationFrame struct {
+	x_scale  *axisScale
+	y_scale  *axisScale
+	x2_scale *axisScale
+	y2_scale *axisScale
+	width    int64
+	height   int64
+}
+
+//	coordinateValue convert a component of a coordinate into driver coordinates
+func coordinateValue(value float64, system uint8, scale *axisScale, length int64) float64 {
+
+	switch system {
+	case COORDINATES_GRAPH:
+		return scale.offset + value*scale.length
+
+	case COORDINATES_SCREEN:
+		return value * float64(length)
+	}
+
+	return scale.scale(value)
+}
+
+//	xScale return the scale of the x axis for a coordinate system
+func (frame *annotationFrame) xScale(system uint8) *axisScale {
+	if system == COORDINATES_SECOND && frame.x2_scale != nil {
+		return frame.x2_scale
+	}
+
+	return frame.x_scale
+}
+
+//	yScale return the scale of the y axis for a coordinate system
+func (frame *annotationFrame) yScale(system uint8) *axisScale {
+	if system == COORDINATES_SECOND && frame.y2_scale != nil {
+		return frame.y2_scale
+	}
+
+	return frame.y_scale
+}
+
+//	point convert a coordinate into driver coordinates
+func (frame *annotationFrame) point(position Coordinate) (float64, float64) {
+	return coordinateValue(position.X, position.X_system, frame.xScale(position.X_system), frame.width),
+		coordinateValue(position.Y, position.Y_system, frame.yScale(position.Y_system), frame.height)
+}
+
+//	length convert a distance from a point into a length in driver coordinates
+func (frame *annotationFrame) length(from float64, value float64, system uint8, scale *axisScale, length int64) float64 {
+
+	switch system {
+	case COORDINATES_GRAPH:
+		return value * scale.length
+
+	case COORDINATES_SCREEN:
+		return value * float64(length)
+	}
+
+	return math.Abs(scale.scale(from+value) - scale.scale(from))
+}
+
+//	generateAnnotations implementation of 2D Go_Plot annotations generation for a layer
+func (p *Plot_2D) generateAnnotations(driver GraphicsDriver, x_scale, y_scale, x2_scale, y2_scale *axisScale, front bool) error {
+
+	if len(p.Annotations) == 0 {
+		return nil
+	}
+
+	//	get plot dimention from driver's default or from plot parameters when present
+	width, height := driver.GetDimensions()
+
+	if p.Width > 0 {
+		width = p.Width
+	}
+	if p.Height > 0 {
+		height = p.Height
+	}
+
+	frame := &annotationFrame{
+		x_scale:  x_scale,
+		y_scale:  y_scale,
+		x2_scale: x2_scale,
+		y2_scale: y2_scale,
+		width:    width,
+		height:   height,
+	}
+
+	for _, annotation := range p.Annotations {
+		if annotation.front() != front {
+			continue
+		}
+
+		err := annotation.Validate()
+		if err != nil {
+			return err
+		}
+
+		err = annotation.generate(driver, frame)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//	generate draw the annotation
+func (annotation *Annotation) generate(driver GraphicsDriver, frame *annotationFrame) error {
+
+	colour := BLACK
+	if annotation.Line_style.Colour != nil {
+		colour = *annotation.Line_style.Colour
+	}
+
+	err := driver.SetLineStyle(annotation.Line_style.lineWidth(), annotation.Line_style.dashType())
+	if err != nil {
+		return err
+	}
+	defer driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+
+	x, y := frame.point(annotation.Position[0])
+
+	switch annotation.Type {
+	case ANNOTATION_LABEL:
+		driver.Comment("label " + annotation.Text)
+
+		return annotation.generateLabel(driver, x, y, colour)
+
+	case ANNOTATION_ARROW:
+		driver.Comment("arrow")
+
+		x2, y2 := frame.point(annotation.Position[1])
+		driver.Line(int64(x), int64(y), int64(x2), int64(y2), colour)
+
+		if annotation.Head == ARROW_HEAD || annotation.Head == ARROW_HEADS {
+			generateArrowHead(driver, x, y, x2, y2, ARROW_HEAD_LENGTH, ARROW_HEAD_ANGLE, colour)
+		}
+		if annotation.Head == ARROW_BACKHEAD || annotation.Head == ARROW_HEADS {
+			generateArrowHead(driver, x2, y2, x, y, ARROW_HEAD_LENGTH, ARROW_HEAD_ANGLE, colour)
+		}
+
+	case ANNOTATION_RECTANGLE:
+		driver.Comment("rectangle object")
+
+		x2, y2 := frame.point(annotation.Position[1])
+
+		annotation.generateShape(driver, []DriverPoint{
+			{X: int64(x), Y: int64(y)},
+			{X: int64(x2), Y: int64(y)},
+			{X: int64(x2), Y: int64(y2)},
+			{X: int64(x), Y: int64(y2)},
+		}, colour)
+
+	case ANNOTATION_CIRCLE:
+		driver.Comment("circle object")
+
+		radius := frame.length(annotation.Position[0].X, annotation.Size.X, annotation.Size.X_system, frame.xScale(annotation.Size.X_system), frame.width)
+
+		if annotation.Fill_colour != nil {
+			driver.Circle(int64(x), int64(y), int64(math.Round(radius)), *annotation.Fill_colour, true)
+		}
+		if !annotation.No_border {
+			driver.Circle(int64(x), int64(y), int64(math.Round(radius)), colour, false)
+		}
+
+	case ANNOTATION_ELLIPSE:
+		driver.Comment("ellipse object")
+
+		semi_x := frame.length(annotation.Position[0].X, annotation.Size.X, annotation.Size.X_system, frame.xScale(annotation.Size.X_system), frame.width) / 2
+		semi_y := frame.length(annotation.Position[0].Y, annotation.Size.Y, annotation.Size.Y_system, frame.yScale(annotation.Size.Y_system), frame.height) / 2
+		angle := annotation.Angle * math.Pi / 180
+
+		vertex := make([]DriverPoint, ELLIPSE_VERTICES)
+		for i := range vertex {
+			t := 2 * math.Pi * float64(i) / ELLIPSE_VERTICES
+
+			vertex[i] = DriverPoint{
+				X: int64(math.Round(x + semi_x*math.Cos(t)*math.Cos(angle) - semi_y*math.Sin(t)*math.Sin(angle))),
+				Y: int64(math.Round(y + semi_x*math.Cos(t)*math.Sin(angle) + semi_y*math.Sin(t)*math.Cos(angle))),
+			}
+		}
+		annotation.generateShape(driver, vertex, colour)
+
+	case ANNOTATION_POLYGON:
+		driver.Comment("polygon object")
+
+		vertex := make([]DriverPoint, len(annotation.Position))
+		for i, position := range annotation.Position {
+			vertex_x, vertex_y := frame.point(position)
+
+			vertex[i] = DriverPoint{X: int64(vertex_x), Y: int64(vertex_y)}
+		}
+		annotation.generateShape(driver, vertex, colour)
+	}
+
+	return nil
+}
+
+//	generateLabel draw the text of a label using it's font, justification and rotation
+func (annotation *Annotation) generateLabel(driver GraphicsDriver, x, y float64, colour RGB_colour) error {
+
+	//	change the font only for this label
+	if len(annotation.Font_family) > 0 || annotation.Font_size > 0 {
+		fontFamily, fontSize := driver.GetFont()
+		labelFamily, labelSize := fontFamily, fontSize
+
+		if len(annotation.Font_family) > 0 {
+			labelFamily = annotation.Font_family
+		}
+		if annotation.Font_size > 0 {
+			labelSize = annotation.Font_size
+		}
+
+		//	when the font is not available, the label is drawn with the plot's font
+		err := driver.SetFont(labelFamily, labelSize)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[warning] font not available for label %s: %s\n", annotation.Text, err.Error())
+		}
+		defer driver.SetFont(fontFamily, fontSize)
+	}
+
+	textWidth, _ := driver.GetTextBox(annotation.Text)
+
+	//	move the beginning of the text along it's direction
+	var shift float64
+
+	switch annotation.Justification {
+	case JUSTIFY_CENTER:
+		shift = float64(textWidth) / 2
+
+	case JUSTIFY_RIGHT:
+		shift = float64(textWidth)
+	}
+	angle := annotation.Angle * math.Pi / 180
+
+	x -= shift * math.Cos(angle)
+	y -= shift * math.Sin(angle)
+
+	//	drivers rotate the text clockwise
+	return driver.Text(int64(x), int64(y), -int64(annotation.Angle), annotation.Text, colour)
+}
+
+//	generateShape draw the interior and the border of a shape object
+func (annotation *Annotation) generateShape(driver GraphicsDriver, vertex []DriverPoint, colour RGB_colour) {
+
+	if annotation.Fill_colour != nil {
+		driver.Polygon(vertex, *annotation.Fill_colour, true)
+	}
+	if !annotation.No_border {
+		driver.Polygon(vertex, colour, false)
+	}
+}
+
+//	generateArrowHead draw a filled head, with the informed length and half opening angle, in the end of a line
+func generateArrowHead(driver GraphicsDriver, x1, y1, x2, y2, length, headAngle float64, colour RGB_colour) {
+
+	angle := math.Atan2(y2-y1, x2-x1)
+
+	driver.Polygon([]DriverPoint{
+		{X: int64(x2), Y: int64(y2)},
+		{X: int64(x2 - length*math.Cos(angle-headAngle)), Y: int64(y2 - length*math.Sin(angle-headAngle))},
+		{X: int64(x2 - length*math.Cos(angle+headAngle)), Y: int64(y2 - length*math.Sin(angle+headAngle))},
+	}, colour, true)
+}
@@ -0,0 +1,119 @@
+////////////////////////////////////////////////////////////////////////////////
+//	annotation_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for labels, arrows and shape objects
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestAnnotationFrame unit tests for the conversion of annotation coordinates
+func TestAnnotationFrame(t *testing.T) {
+
+	frame := &annotationFrame{
+		x_scale: newAxisScale(&Axis{}, 0, 10, X_MARGINS, 100),
+		y_scale: newAxisScale(&Axis{}, -1, 1, Y_MARGINS, 50),
+		width:   160,
+		height:  110,
+	}
+
+	t.Run(">>> point: first, graph and screen coordinates", func(t *testing.T) {
+
+		want := []float64{80, 55, 80, 80, 16, 55}
+		var got []float64
+
+		for _, position := range []Coordinate{
+			{X: 5, Y: 0},
+			{X: 0.5, Y: 1, X_system: COORDINATES_GRAPH, Y_system: COORDINATES_GRAPH},
+			{X: 0.1, Y: 0.5, X_system: COORDINATES_SCREEN, Y_system: COORDINATES_SCREEN},
+		} {
+			x, y := frame.point(position)
+			got = append(got, x, y)
+		}
+
+		//	check the result
+		for i := range want {
+			if want[i] != got[i] {
+				t.Errorf("failed converting coordinates: expected: %v result: %v", want, got)
+				return
+			}
+		}
+	})
+
+	t.Run(">>> length: size in first coordinates", func(t *testing.T) {
+
+		want := float64(20)
+		got := frame.length(3, 2, COORDINATES_FIRST, frame.x_scale, frame.width)
+		//	check the result
+		if want != got {
+			t.Errorf("failed converting length: expected: %f result: %f", want, got)
+		}
+	})
+}
+
+//	TestRotatedText unit tests for the rotation of text in the graphics drivers
+func TestRotatedText(t *testing.T) {
+
+	t.Run(">>> Image_Driver.Text: text rotated around the start of the baseline", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		driver := newImage_Driver(bufio.NewWriter(&output), "png", &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		err := driver.SetFont("Verdana", 10)
+		if err != nil {
+			t.Errorf("fail setting font: %s", err.Error())
+			return
+		}
+
+		err = driver.Text(50, 10, -90, "label", BLACK)
+		if err != nil {
+			t.Errorf("fail drawing text: %s", err.Error())
+			return
+		}
+
+		//	check the result: the text goes up from (50, 90) in image coordinates, with the glyphs to the left of the baseline
+		textWidth, _ := driver.GetTextBox("label")
+		var painted int
+
+		for j := 0; j < 100; j++ {
+			for i := 0; i < 100; i++ {
+				if driver.image.RGBAAt(i, j).R == 255 {
+					continue
+				}
+				painted++
+
+				if i < 35 || i > 52 || j < 90-int(textWidth)-2 || j > 92 {
+					t.Errorf("failed drawing rotated text: expected pixels above and to the left of (50, 90) result: (%d, %d)", i, j)
+					return
+				}
+			}
+		}
+		if painted == 0 {
+			t.Errorf("failed drawing rotated text: expected painted pixels")
+		}
+	})
+
+	t.Run(">>> Canvas_Driver.Text: rotated text", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewCanvas_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+		driver.Text(50, 10, -90, "label", BLACK)
+		writer.Flush()
+
+		//	check the result
+		if !strings.Contains(output.String(), "ctx.translate(50, 90);\n  ctx.rotate(-90 * Math.PI / 180);\n  ctx.fillText(\"label\", 0, 0);") {
+			t.Errorf("failed generating canvas: expected rotated text result: %s", output.String())
+		}
+	})
+}
@@ -0,0 +1,240 @@
+////////////////////////////////////////////////////////////////////////////////
+//	axis.go  -  Oct-19-2026  -  aldebap
+//
+//	Axis transformation used to map plot values into driver coordinates
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+//	default base for logarithmic axes
+const (
+	DEFAULT_LOG_BASE = 10
+)
+
+//	pairs of axes used to plot a series
+const (
+	AXES_X1Y1 uint8 = 0
+	AXES_X1Y2 uint8 = 1
+	AXES_X2Y1 uint8 = 2
+	AXES_X2Y2 uint8 = 3
+)
+
+//	names of the pairs of axes
+var Axes_pair = map[string]uint8{
+	"x1y1": AXES_X1Y1,
+	"x1y2": AXES_X1Y2,
+	"x2y1": AXES_X2Y1,
+	"x2y2": AXES_X2Y2,
+}
+
+//	attributes used to describe a plot axis (nil limits are autoscaled)
+type Axis struct {
+	Log_scale  bool
+	Log_base   float64
+	Min        *float64
+	Max        *float64
+	Tics       *Tics_series
+	Tic_labels []Tic_label
+	Minor_tics int
+	Format     string
+}
+
+//	interval of values of the series plotted in an axis
+type axisRange struct {
+	min  float64
+	max  float64
+	used bool
+}
+
+//	transformation of an axis range into an interval of driver coordinates
+type axisScale struct {
+	axis   *Axis
+	min    float64
+	max    float64
+	log    bool
+	base   float64
+	offset float64
+	length float64
+}
+
+//	newAxisScale create the transformation for an axis range into driver coordinates
+func newAxisScale(axis *Axis, min, max, offset, length float64) *axisScale {
+
+	scale := &axisScale{
+		axis:   axis,
+		min:    min,
+		max:    max,
+		offset: offset,
+		length: length,
+	}
+
+	if axis != nil && axis.Log_scale {
+		scale.log = true
+		scale.base = axis.logBase()
+	}
+
+	return scale
+}
+
+//	logBase return the base of a logarithmic axis
+func (axis *Axis) logBase() float64 {
+	if axis.Log_base <= 1 {
+		return DEFAULT_LOG_BASE
+	}
+
+	return axis.Log_base
+}
+
+//	valid check if a value can be represented in the axis
+func (axis *Axis) valid(value float64) bool {
+	return !axis.Log_scale || value > 0
+}
+
+//	extend include an interval in the axis range
+func (r *axisRange) extend(min, max float64) {
+	if !r.used {
+		r.min, r.max, r.used = min, max, true
+		return
+	}
+
+	if min < r.min {
+		r.min = min
+	}
+	if max > r.max {
+		r.max = max
+	}
+}
+
+//	configured check if a secondary axis has any attribute of it's own
+func (axis *Axis) configured() bool {
+	return axis.Log_scale || axis.Min != nil || axis.Max != nil || axis.Tics != nil || len(axis.Tic_labels) > 0
+}
+
+//	limits return the axis range replacing the autoscaled limits by the fixed ones
+func (axis *Axis) limits(min, max float64) (float64, float64, error) {
+	if axis.Min == nil && axis.Max == nil {
+		return min, max, nil
+	}
+
+	if axis.Min != nil {
+		min = *axis.Min
+	}
+	if axis.Max != nil {
+		max = *axis.Max
+	}
+	if min >= max {
+		return 0, 0, errors.New("axis range minimum expected to be less than maximum: " + strconv.FormatFloat(min, 'g', -1, 64) + ":" + strconv.FormatFloat(max, 'g', -1, 64))
+	}
+
+	return min, max, nil
+}
+
+//	seriesScales return the scales of the pair of axes used by a series
+func seriesScales(axes uint8, x_scale, y_scale, x2_scale, y2_scale *axisScale) (*axisScale, *axisScale) {
+
+	switch axes {
+	case AXES_X1Y2:
+		return x_scale, y2_scale
+
+	case AXES_X2Y1:
+		return x2_scale, y_scale
+
+	case AXES_X2Y2:
+		return x2_scale, y2_scale
+	}
+
+	return x_scale, y_scale
+}
+
+//	roundRange extend an autoscaled range to the axis' major ticks
+func (axis *Axis) roundRange(min, max float64, divisions int64) (float64, float64) {
+
+	if axis.Log_scale {
+		base := axis.logBase()
+
+		min = math.Pow(base, math.Floor(math.Log(min)/math.Log(base)))
+		max = math.Pow(base, math.Ceil(math.Log(max)/math.Log(base)))
+		if min == max {
+			max = min * base
+		}
+
+		return min, max
+	}
+
+	//	a range with a single value is extended around it
+	if min == max {
+		min -= 1
+		max += 1
+	}
+
+	//	round the scale to multiples of the tics increment
+	step := niceStep((max - min) / float64(divisions))
+	if increment := axis.Tics.explicitIncrement(min, max); increment > 0 {
+		step = increment
+	}
+
+	min = math.Floor(min/step+TICK_EPSILON) * step
+	max = math.Ceil(max/step-TICK_EPSILON) * step
+
+	return min, max
+}
+
+//	transform apply the axis transformation to a value
+func (s *axisScale) transform(value float64) float64 {
+	if s.log {
+		return math.Log(value) / math.Log(s.base)
+	}
+
+	return value
+}
+
+//	scale map a value into driver coordinates
+func (s *axisScale) scale(value float64) float64 {
+
+	//	non positive values are mapped to the beginning of a logarithmic axis
+	if s.log && value <= 0 {
+		return s.offset
+	}
+
+	min := s.transform(s.min)
+	max := s.transform(s.max)
+
+	return s.offset + s.length*(s.transform(value)-min)/(max-min)
+}
+
+//	contains check if a value is inside the axis range
+func (s *axisScale) contains(value float64) bool {
+	if s.log && value <= 0 {
+		return false
+	}
+
+	return value >= s.min && value <= s.max
+}
+
+//	value return the value at a fraction of the axis' transformed interval
+func (s *axisScale) value(fraction float64) float64 {
+	min := s.transform(s.min)
+	max := s.transform(s.max)
+
+	value := min + fraction*(max-min)
+	if s.log {
+		return math.Pow(s.base, value)
+	}
+
+	return value
+}
+
+//	origin return the value used as the base of boxes
+func (s *axisScale) origin() float64 {
+	if s.log {
+		return s.min
+	}
+
+	return 0
+}
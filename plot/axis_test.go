@@ -0,0 +1,123 @@
+////////////////////////////////////////////////////////////////////////////////
+//	axis_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the axis transformation
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+//	TestAxisScale unit tests for axisScale.scale()
+func TestAxisScale(t *testing.T) {
+
+	t.Run(">>> scale: linear axis", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{}, 0, 100, 30, 200)
+
+		want := float64(130)
+		got := testScale.scale(50)
+		//	check the result
+		if want != got {
+			t.Errorf("failed scaling value: expected: %f result: %f", want, got)
+		}
+	})
+
+	t.Run(">>> scale: logarithmic axis", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{Log_scale: true}, 1, 1000, 30, 300)
+
+		want := float64(230)
+		got := testScale.scale(100)
+		//	check the result
+		if math.Abs(want-got) > 1e-9 {
+			t.Errorf("failed scaling value: expected: %f result: %f", want, got)
+		}
+
+		want = float64(30)
+		got = testScale.scale(-5)
+		//	non positive values are expected to be mapped to the axis origin
+		if want != got {
+			t.Errorf("failed scaling non positive value: expected: %f result: %f", want, got)
+		}
+	})
+}
+
+//	TestRoundRange unit tests for Axis.roundRange()
+func TestRoundRange(t *testing.T) {
+
+	t.Run(">>> roundRange: linear axis", func(t *testing.T) {
+
+		testAxis := &Axis{}
+
+		got_min, got_max := testAxis.roundRange(0.3, 6.28, MIN_X_SCALE_DIVISIONS)
+		//	check the result
+		if math.Abs(got_min-0) > 1e-9 || math.Abs(got_max-6.5) > 1e-9 {
+			t.Errorf("failed rounding range: expected: 0, 6.5 result: %f, %f", got_min, got_max)
+		}
+	})
+
+	t.Run(">>> roundRange: logarithmic axis", func(t *testing.T) {
+
+		testAxis := &Axis{Log_scale: true}
+
+		got_min, got_max := testAxis.roundRange(3, 65000, MIN_Y_SCALE_DIVISIONS)
+		//	check the result
+		if got_min != 1 || got_max != 100000 {
+			t.Errorf("failed rounding range: expected: 1, 100000 result: %f, %f", got_min, got_max)
+		}
+	})
+}
+
+//	TestLimits unit tests for Axis.limits()
+func TestLimits(t *testing.T) {
+
+	t.Run(">>> limits: fixed minimum and autoscaled maximum", func(t *testing.T) {
+
+		min := 0.0
+		testAxis := &Axis{Min: &min}
+
+		got_min, got_max, err := testAxis.limits(-3, 7)
+		//	check the result
+		if err != nil || got_min != 0 || got_max != 7 {
+			t.Errorf("failed evaluating limits: expected: 0, 7 result: %f, %f (%v)", got_min, got_max, err)
+		}
+	})
+
+	t.Run(">>> limits: fixed minimum above the autoscaled maximum", func(t *testing.T) {
+
+		min := 10.0
+		testAxis := &Axis{Min: &min}
+
+		_, _, err := testAxis.limits(-3, 7)
+		//	check the result
+		if err == nil {
+			t.Errorf("error expected evaluating limits")
+		}
+	})
+}
+
+//	TestSeriesScales unit tests for seriesScales()
+func TestSeriesScales(t *testing.T) {
+
+	t.Run(">>> seriesScales: every pair of axes", func(t *testing.T) {
+
+		x, y, x2, y2 := &axisScale{}, &axisScale{}, &axisScale{}, &axisScale{}
+
+		for axes, want := range map[uint8][2]*axisScale{
+			AXES_X1Y1: {x, y},
+			AXES_X1Y2: {x, y2},
+			AXES_X2Y1: {x2, y},
+			AXES_X2Y2: {x2, y2},
+		} {
+			got_x, got_y := seriesScales(axes, x, y, x2, y2)
+			//	check the result
+			if got_x != want[0] || got_y != want[1] {
+				t.Errorf("failed choosing scales for axes %d", axes)
+			}
+		}
+	})
+}
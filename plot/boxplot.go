@@ -0,0 +1,190 @@
+////////////////////////////////////////////////////////////////////////////////
+//	boxplot.go  -  Oct-19-2026  -  aldebap
+//
+//	Box-and-whisker and violin styles of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"strconv"
+)
+
+const (
+	DEFAULT_BOXPLOT_RANGE = 1.5
+	BOXPLOT_WIDTH         = 0.5
+	VIOLIN_SAMPLES        = 50
+)
+
+//	attributes used to describe the whiskers of box-and-whisker plots (the range is in interquartile distances)
+type Boxplot_style struct {
+	Range       float64
+	No_outliers bool
+}
+
+//	whiskerRange return the range of the whiskers (the default one when not informed)
+func (style *Boxplot_style) whiskerRange() float64 {
+	if style.Range <= 0 {
+		return DEFAULT_BOXPLOT_RANGE
+	}
+
+	return style.Range
+}
+
+//	width return the width of the boxes of a box-and-whisker or violin plot
+func (set *Set_points_2d) width() float64 {
+	if set.boxWidth <= 0 {
+		return BOXPLOT_WIDTH
+	}
+
+	return set.boxWidth
+}
+
+//	sampleGroups group the y values of the set by their x, in the order they appear
+func (set *Set_points_2d) sampleGroups() ([]float64, map[float64][]float64) {
+
+	var position []float64
+	sample := make(map[float64][]float64)
+
+	for _, point := range set.Point {
+		if _, found := sample[point.X]; !found {
+			position = append(position, point.X)
+		}
+		sample[point.X] = append(sample[point.X], point.Y)
+	}
+
+	return position, sample
+}
+
+//	generateBoxplot generate a box-and-whisker or a violin shape for the sample at each x of the set
+func (set *Set_points_2d) generateBoxplot(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour, pointType uint8, pointWidth float64) {
+
+	position, sample := set.sampleGroups()
+	halfWidth := set.width() / 2
+
+	for _, x := range position {
+		statistics, err := BoxStatistics(sample[x], set.boxplot.whiskerRange())
+		if err != nil {
+			continue
+		}
+
+		scaled_x := int64(x_scale.scale(x))
+		scaled_left := int64(x_scale.scale(x - halfWidth))
+		scaled_right := int64(x_scale.scale(x + halfWidth))
+		scaled_median := int64(clamp(y_scale.scale(statistics.Median), y_scale))
+
+		if set.Style == VIOLIN {
+			set.generateViolin(driver, x_scale, y_scale, x, sample[x], colour)
+			driver.Line(int64(x_scale.scale(x-halfWidth/2)), scaled_median, int64(x_scale.scale(x+halfWidth/2)), scaled_median, BLACK)
+			continue
+		}
+
+		//	the box goes from the lower to the upper quartile, with a line in the median
+		scaled_lower := int64(clamp(y_scale.scale(statistics.Lower_quartile), y_scale))
+		scaled_upper := int64(clamp(y_scale.scale(statistics.Upper_quartile), y_scale))
+
+		box := []DriverPoint{
+			{X: scaled_left, Y: scaled_lower},
+			{X: scaled_right, Y: scaled_lower},
+			{X: scaled_right, Y: scaled_upper},
+			{X: scaled_left, Y: scaled_upper},
+		}
+
+		if scaled_lower != scaled_upper {
+			driver.FillPolygon(box, colour, set.Fill)
+		}
+		driver.Polygon(box, colour, false)
+		driver.Line(scaled_left, scaled_median, scaled_right, scaled_median, BLACK)
+
+		//	the whiskers go from the box to the farthest values within the range
+		scaled_lower_whisker := int64(clamp(y_scale.scale(statistics.Lower_whisker), y_scale))
+		scaled_upper_whisker := int64(clamp(y_scale.scale(statistics.Upper_whisker), y_scale))
+		scaled_whisker_left := int64(x_scale.scale(x - halfWidth/2))
+		scaled_whisker_right := int64(x_scale.scale(x + halfWidth/2))
+
+		driver.Line(scaled_x, scaled_lower, scaled_x, scaled_lower_whisker, colour)
+		driver.Line(scaled_x, scaled_upper, scaled_x, scaled_upper_whisker, colour)
+		driver.Line(scaled_whisker_left, scaled_lower_whisker, scaled_whisker_right, scaled_lower_whisker, colour)
+		driver.Line(scaled_whisker_left, scaled_upper_whisker, scaled_whisker_right, scaled_upper_whisker, colour)
+
+		if set.boxplot.No_outliers {
+			continue
+		}
+		for _, outlier := range statistics.Outliers {
+			drawMarker(driver, x_scale.scale(x), y_scale.scale(outlier), pointType, pointWidth, colour)
+		}
+	}
+}
+
+//	generateViolin generate a shape symmetric around x, whose width is the kernel density estimate of the sample
+//	between it's lowest and highest values
+func (set *Set_points_2d) generateViolin(driver GraphicsDriver, x_scale, y_scale *axisScale, x float64, sample []float64, colour RGB_colour) {
+
+	low, high := sample[0], sample[0]
+	for _, value := range sample {
+		if value < low {
+			low = value
+		}
+		if value > high {
+			high = value
+		}
+	}
+
+	//	without spread in the sample, the violin is a single line
+	bandwidth := SilvermanBandwidth(sample)
+	if bandwidth <= 0 || high == low {
+		scaled_value := int64(clamp(y_scale.scale(low), y_scale))
+
+		driver.Line(int64(x_scale.scale(x-set.width()/2)), scaled_value, int64(x_scale.scale(x+set.width()/2)), scaled_value, colour)
+		return
+	}
+
+	value := make([]float64, VIOLIN_SAMPLES+1)
+	for i := range value {
+		value[i] = low + (high-low)*float64(i)/VIOLIN_SAMPLES
+	}
+
+	density, err := KernelDensity(sample, bandwidth, value)
+	if err != nil {
+		return
+	}
+
+	maxDensity := 0.0
+	for _, d := range density {
+		if d > maxDensity {
+			maxDensity = d
+		}
+	}
+
+	//	the widest part of the violin has the width of a box
+	polygon := make([]DriverPoint, 2*len(value))
+
+	for i := range value {
+		halfWidth := set.width() / 2 * density[i] / maxDensity
+		scaled_y := int64(clamp(y_scale.scale(value[i]), y_scale))
+
+		polygon[i] = DriverPoint{X: int64(x_scale.scale(x + halfWidth)), Y: scaled_y}
+		polygon[len(polygon)-1-i] = DriverPoint{X: int64(x_scale.scale(x - halfWidth)), Y: scaled_y}
+	}
+
+	driver.FillPolygon(polygon, colour, set.Fill)
+	driver.Polygon(polygon, colour, false)
+}
+
+//	parseBoxplotStyle parse the options of a set style boxplot command: [range r] [outliers|nooutliers]
+func parseBoxplotStyle(whiskerRange, outliers string) (*Boxplot_style, error) {
+
+	boxplot := Boxplot_style{No_outliers: outliers == "nooutliers"}
+
+	if len(whiskerRange) > 0 {
+		var err error
+
+		boxplot.Range, err = strconv.ParseFloat(whiskerRange, 64)
+		if err != nil || boxplot.Range <= 0 {
+			return nil, errors.New("invalid boxplot range: " + whiskerRange)
+		}
+	}
+
+	return &boxplot, nil
+}
@@ -10,6 +10,7 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 type Canvas_Driver struct {
@@ -17,30 +18,41 @@ type Canvas_Driver struct {
 	functionName string
 	width        int64
 	height       int64
+	background   RGB_colour
 	path         []DriverPoint
 	pathColour   RGB_colour
+	lineWidth    float64
+	dashType     uint8
 	fontFamily   string
 	fontSize     uint8
 }
 
 //	NewCanvas_Driver create a new Canvas_Driver
-func NewCanvas_Driver(writer *bufio.Writer) GraphicsDriver {
+func NewCanvas_Driver(writer *bufio.Writer, options *TerminalOptions) GraphicsDriver {
 	const (
-		PLOT_FUNCTION = "canvas_plot"
-		WIDTH         = 600
-		HEIGHT        = 400
-		FONT_FAMILY   = "Verdana"
-		FONT_SIZE     = 10
+		WIDTH  = 600
+		HEIGHT = 400
 	)
 
+	width, height := options.dimensions(WIDTH, HEIGHT)
+	functionName := DEFAULT_CANVAS_NAME
+
+	//	an invalid name would break the generated JavaScript
+	if options != nil && ValidateCanvasName(options.Name) == nil {
+		functionName = options.Name
+	}
+
 	return &Canvas_Driver{
 		writer:       writer,
-		functionName: PLOT_FUNCTION,
-		width:        WIDTH,
-		height:       HEIGHT,
+		functionName: functionName,
+		width:        width,
+		height:       height,
+		background:   options.background(),
 		path:         nil,
-		fontFamily:   FONT_FAMILY,
-		fontSize:     FONT_SIZE,
+		lineWidth:    DEFAULT_LINE_WIDTH,
+		dashType:     DASH_SOLID,
+		fontFamily:   options.fontFamily(),
+		fontSize:     options.fontSize(),
 	}
 }
 
@@ -59,7 +71,7 @@ func (driver *Canvas_Driver) SetDimensions(width int64, height int64) error {
 		"  let ctx = canvas.getContext(\"2d\");\n\n")
 
 	driver.Comment("image background")
-	driver.writer.WriteString("  ctx.fillStyle = \"#FFFFFF\";\n")
+	driver.writer.WriteString("  ctx.fillStyle = \"#" + driver.background.Hexa() + "\";\n")
 	driver.writer.WriteString("  ctx.fillRect(0, 0, " + fmt.Sprintf("%d", width) + ", " + fmt.Sprintf("%d", height) + ");\n")
 
 	return nil
@@ -78,6 +90,30 @@ func (driver *Canvas_Driver) SetFont(fontFamily string, fontSize uint8) error {
 	return nil
 }
 
+//	SetLineStyle set the width and dash type used to draw lines and paths
+func (driver *Canvas_Driver) SetLineStyle(width float64, dashType uint8) error {
+	if width <= 0 {
+		return errors.New("line width expected to be positive")
+	}
+
+	//	the canvas context keeps the line style, so it's changed only when necessary
+	if width != driver.lineWidth {
+		driver.writer.WriteString("  ctx.lineWidth = " + fmt.Sprintf("%g", width) + ";\n")
+	}
+	if dashType != driver.dashType || (width != driver.lineWidth && dashType != DASH_SOLID) {
+		pattern := dashPattern(dashType, width)
+		dashes := make([]string, len(pattern))
+		for i, length := range pattern {
+			dashes[i] = fmt.Sprintf("%g", length)
+		}
+		driver.writer.WriteString("  ctx.setLineDash([" + strings.Join(dashes, ", ") + "]);\n")
+	}
+	driver.lineWidth = width
+	driver.dashType = dashType
+
+	return nil
+}
+
 //	Comment write a comment int the the SVG graphic
 func (driver *Canvas_Driver) Comment(text string) {
 	driver.writer.WriteString("// " + text + "\n")
@@ -146,6 +182,133 @@ func (driver *Canvas_Driver) Line(x1, y1, x2, y2 int64, colour RGB_colour) error
 	return nil
 }
 
+//	Polygon draws an open or filled polygon in the Canvas graphic
+func (driver *Canvas_Driver) Polygon(point []DriverPoint, colour RGB_colour, filled bool) error {
+	if len(point) < 3 {
+		return errors.New("not enough points to draw a polygon")
+	}
+
+	driver.writer.WriteString("  ctx.beginPath();\n")
+	for i, vertex := range point {
+		if i == 0 {
+			driver.writer.WriteString("  ctx.moveTo(" + fmt.Sprintf("%d", vertex.X) + ", " + fmt.Sprintf("%d", driver.height-vertex.Y) + ");\n")
+		} else {
+			driver.writer.WriteString("  ctx.lineTo(" + fmt.Sprintf("%d", vertex.X) + ", " + fmt.Sprintf("%d", driver.height-vertex.Y) + ");\n")
+		}
+	}
+	driver.writer.WriteString("  ctx.closePath();\n")
+	driver.shape(colour, filled)
+
+	return nil
+}
+
+//	FillPolygon draws the interior of a polygon with a fill style in the Canvas graphic
+func (driver *Canvas_Driver) FillPolygon(point []DriverPoint, colour RGB_colour, fill Fill_style) error {
+	if len(point) < 3 {
+		return errors.New("not enough points to draw a polygon")
+	}
+
+	fillType := fill.effectiveType()
+	if fillType == FILL_EMPTY {
+		return nil
+	}
+
+	driver.writer.WriteString("  ctx.beginPath();\n")
+	for i, vertex := range point {
+		if i == 0 {
+			driver.writer.WriteString("  ctx.moveTo(" + fmt.Sprintf("%d", vertex.X) + ", " + fmt.Sprintf("%d", driver.height-vertex.Y) + ");\n")
+		} else {
+			driver.writer.WriteString("  ctx.lineTo(" + fmt.Sprintf("%d", vertex.X) + ", " + fmt.Sprintf("%d", driver.height-vertex.Y) + ");\n")
+		}
+	}
+	driver.writer.WriteString("  ctx.closePath();\n")
+
+	if fillType == FILL_PATTERN {
+		//	the pattern's tile is drawn in an offscreen canvas, after the polygon is painted with the background when not transparent
+		lines := fill.lines()
+
+		if !fill.Transparent {
+			driver.writer.WriteString("  ctx.fillStyle = \"#" + driver.background.Hexa() + "\";\n")
+			driver.writer.WriteString("  ctx.fill();\n")
+		}
+
+		driver.writer.WriteString("  {\n" +
+			"    let tile = document.createElement(\"canvas\");\n" +
+			"    tile.width = " + fmt.Sprintf("%d", lines.spacing) + ";\n" +
+			"    tile.height = " + fmt.Sprintf("%d", lines.spacing) + ";\n" +
+			"    let tileCtx = tile.getContext(\"2d\");\n" +
+			"    tileCtx.strokeStyle = \"#" + colour.Hexa() + "\";\n" +
+			"    tileCtx.beginPath();\n")
+		for _, segment := range lines.segments() {
+			driver.writer.WriteString("    tileCtx.moveTo(" + fmt.Sprintf("%d", segment[0]) + ", " + fmt.Sprintf("%d", segment[1]) + ");\n")
+			driver.writer.WriteString("    tileCtx.lineTo(" + fmt.Sprintf("%d", segment[2]) + ", " + fmt.Sprintf("%d", segment[3]) + ");\n")
+		}
+		driver.writer.WriteString("    tileCtx.stroke();\n" +
+			"    ctx.fillStyle = ctx.createPattern(tile, \"repeat\");\n" +
+			"    ctx.fill();\n" +
+			"  }\n")
+
+		return nil
+	}
+
+	solidColour := fill.solidColour(colour, driver.background)
+
+	driver.writer.WriteString("  ctx.fillStyle = \"#" + solidColour.Hexa() + "\";\n")
+	if fill.Transparent {
+		driver.writer.WriteString("  ctx.globalAlpha = " + fmt.Sprintf("%g", fill.opacity()) + ";\n")
+		driver.writer.WriteString("  ctx.fill();\n")
+		driver.writer.WriteString("  ctx.globalAlpha = 1;\n")
+	} else {
+		driver.writer.WriteString("  ctx.fill();\n")
+	}
+
+	return nil
+}
+
+//	Circle draws an open or filled circle in the Canvas graphic
+func (driver *Canvas_Driver) Circle(x, y, radius int64, colour RGB_colour, filled bool) error {
+	driver.writer.WriteString("  ctx.beginPath();\n")
+	driver.writer.WriteString("  ctx.arc(" + fmt.Sprintf("%d", x) + ", " + fmt.Sprintf("%d", driver.height-y) + ", " +
+		fmt.Sprintf("%d", radius) + ", 0, 2 * Math.PI);\n")
+	driver.shape(colour, filled)
+
+	return nil
+}
+
+//	Image draws a raster of colours stretched into a rectangle, as a grid of rectangles in the canvas
+func (driver *Canvas_Driver) Image(x, y, width, height int64, pixel [][]RGB_colour) error {
+	if len(pixel) == 0 || width <= 0 || height <= 0 {
+		return errors.New("not enough pixels to draw an image")
+	}
+
+	for j, row := range pixel {
+		y1 := rasterEdge(y, height, j, len(pixel))
+		y2 := rasterEdge(y, height, j+1, len(pixel))
+
+		for i, colour := range row {
+			x1 := rasterEdge(x, width, i, len(row))
+			x2 := rasterEdge(x, width, i+1, len(row))
+
+			driver.writer.WriteString("  ctx.fillStyle = \"#" + colour.Hexa() + "\";\n")
+			driver.writer.WriteString("  ctx.fillRect(" + fmt.Sprintf("%d", x1) + ", " + fmt.Sprintf("%d", driver.height-y2) + ", " +
+				fmt.Sprintf("%d", x2-x1) + ", " + fmt.Sprintf("%d", y2-y1) + ");\n")
+		}
+	}
+
+	return nil
+}
+
+//	shape fill or stroke the current path of the canvas
+func (driver *Canvas_Driver) shape(colour RGB_colour, filled bool) {
+	if filled {
+		driver.writer.WriteString("  ctx.fillStyle = \"#" + colour.Hexa() + "\";\n")
+		driver.writer.WriteString("  ctx.fill();\n")
+	} else {
+		driver.writer.WriteString("  ctx.strokeStyle = \"#" + colour.Hexa() + "\";\n")
+		driver.writer.WriteString("  ctx.stroke();\n")
+	}
+}
+
 //	GetTextBox evaluate the width and height of the rectangle required to draw the text string using a given font size
 func (driver *Canvas_Driver) GetTextBox(text string) (width, height int64) {
 
@@ -158,10 +321,19 @@ func (driver *Canvas_Driver) GetTextBox(text string) (width, height int64) {
 
 //	Text writes a string to the specified point in the SVG graphic
 func (driver *Canvas_Driver) Text(x, y, angle int64, text string, colour RGB_colour) error {
-	driver.writer.WriteString("  ctx.font = \"" + fmt.Sprintf("%d", driver.fontSize) + "px " + driver.fontFamily + "\";\n")
+	driver.writer.WriteString("  ctx.font = \"" + fmt.Sprintf("%d", driver.fontSize) + "px " + javaScriptString(driver.fontFamily) + "\";\n")
 	driver.writer.WriteString("  ctx.fillStyle = \"#" + colour.Hexa() + "\";\n")
-	driver.writer.WriteString("  ctx.fillText(\"" + text + "\", " +
-		fmt.Sprintf("%d", x) + ", " + fmt.Sprintf("%d", driver.height-y) + ");\n")
+
+	if angle == 0 {
+		driver.writer.WriteString("  ctx.fillText(\"" + javaScriptString(text) + "\", " +
+			fmt.Sprintf("%d", x) + ", " + fmt.Sprintf("%d", driver.height-y) + ");\n")
+	} else {
+		driver.writer.WriteString("  ctx.save();\n")
+		driver.writer.WriteString("  ctx.translate(" + fmt.Sprintf("%d", x) + ", " + fmt.Sprintf("%d", driver.height-y) + ");\n")
+		driver.writer.WriteString("  ctx.rotate(" + fmt.Sprintf("%d", angle) + " * Math.PI / 180);\n")
+		driver.writer.WriteString("  ctx.fillText(\"" + javaScriptString(text) + "\", 0, 0);\n")
+		driver.writer.WriteString("  ctx.restore();\n")
+	}
 
 	return nil
 }
@@ -173,3 +345,8 @@ func (driver *Canvas_Driver) Close() error {
 
 	return nil
 }
+
+//	javaScriptString escape a text to be written inside a JavaScript string between double quotes
+func javaScriptString(text string) string {
+	return strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\r", "\\r", "<", "\\x3c").Replace(text)
+}
@@ -0,0 +1,39 @@
+////////////////////////////////////////////////////////////////////////////////
+//	circles.go  -  Oct-19-2026  -  aldebap
+//
+//	Circles style of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import "math"
+
+const (
+	DEFAULT_CIRCLE_RADIUS = 0.02
+	CIRCLE_SIDES          = 72
+)
+
+//	circleRadius return the radius in pixels of the circle of a point, given in units of the x axis by the third column
+//	(a fraction of the width of the plot when not informed)
+func (set *Set_points_2d) circleRadius(point *Point_2d, x_scale *axisScale) float64 {
+
+	if point.Size == nil || *point.Size <= 0 {
+		return DEFAULT_CIRCLE_RADIUS * x_scale.length
+	}
+
+	return math.Abs(x_scale.scale(point.X+*point.Size) - x_scale.scale(point.X))
+}
+
+//	generateCircles generate a circle centered in each point of the set, in the order they appear
+func (set *Set_points_2d) generateCircles(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	for i := range set.Point {
+		point := &set.Point[i]
+		pointColour := set.pointColour(point, colour)
+
+		circle := regularPolygon(x_scale.scale(point.X), y_scale.scale(point.Y), set.circleRadius(point, x_scale), CIRCLE_SIDES, 0)
+
+		driver.FillPolygon(circle, pointColour, set.Fill)
+		driver.Polygon(circle, pointColour, false)
+	}
+}
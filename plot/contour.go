@@ -0,0 +1,572 @@
+////////////////////////////////////////////////////////////////////////////////
+//	contour.go  -  Oct-19-2026  -  aldebap
+//
+//	Contour lines of a 2D Go-Plot, traced with marching squares in a grid of values
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aldebap/go-plot/expression"
+)
+
+//	kinds of levels of contour lines
+const (
+	LEVELS_AUTO        uint8 = 0
+	LEVELS_DISCRETE    uint8 = 1
+	LEVELS_INCREMENTAL uint8 = 2
+)
+
+//	names of the kinds of levels of contour lines
+var Contour_levels_kind = map[string]uint8{
+	"auto":        LEVELS_AUTO,
+	"discrete":    LEVELS_DISCRETE,
+	"incremental": LEVELS_INCREMENTAL,
+}
+
+const (
+	DEFAULT_CONTOUR_LEVELS = 5
+	DEFAULT_ISOSAMPLES     = 10
+
+	CONTOUR_LABEL_MARGIN = 2
+)
+
+//	attributes used to describe the levels of contour lines: auto levels are about Count round values (5 when not
+//	informed), discrete levels are the informed Values and incremental ones go from Start by Increment until End
+//	(or the maximum value when End is not informed)
+type Contour_levels struct {
+	Kind      uint8
+	Count     int
+	Values    []float64
+	Start     float64
+	Increment float64
+	End       *float64
+}
+
+//	attributes used to describe the contour lines of a plot
+type Contour_style struct {
+	Levels    Contour_levels
+	No_labels bool
+}
+
+//	contour line of a level (a closed line finishes in it's first point)
+type Contour_path struct {
+	Level float64
+	Point []Point_2d
+}
+
+//	Closed check if the contour line is a loop
+func (path *Contour_path) Closed() bool {
+	if len(path.Point) < 3 {
+		return false
+	}
+	first, last := path.Point[0], path.Point[len(path.Point)-1]
+
+	return first.X == last.X && first.Y == last.Y
+}
+
+//	Validate check if the levels of contour lines can be evaluated
+func (levels *Contour_levels) Validate() error {
+
+	switch levels.Kind {
+	case LEVELS_AUTO:
+		if levels.Count < 0 {
+			return errors.New("number of contour levels expected to be positive")
+		}
+
+	case LEVELS_DISCRETE:
+		if len(levels.Values) == 0 {
+			return errors.New("discrete contour levels require at least one value")
+		}
+
+	case LEVELS_INCREMENTAL:
+		if levels.Increment <= 0 {
+			return errors.New("increment of contour levels expected to be positive")
+		}
+		if levels.End != nil && *levels.End < levels.Start {
+			return errors.New("end of contour levels expected to be greater than start")
+		}
+
+	default:
+		return errors.New("invalid kind of contour levels")
+	}
+
+	return nil
+}
+
+//	Levels return the levels of contour lines for the values from min to max
+func (levels *Contour_levels) Levels(min, max float64) []float64 {
+
+	var level []float64
+
+	switch levels.Kind {
+	case LEVELS_DISCRETE:
+		for _, value := range levels.Values {
+			if value >= min && value <= max {
+				level = append(level, value)
+			}
+		}
+
+	case LEVELS_INCREMENTAL:
+		if levels.Increment <= 0 {
+			break
+		}
+
+		end := max
+		if levels.End != nil && *levels.End < max {
+			end = *levels.End
+		}
+
+		for i := 0; ; i++ {
+			value := roundToStep(levels.Start+float64(i)*levels.Increment, levels.Increment)
+			if value > end {
+				break
+			}
+			if value >= min {
+				level = append(level, value)
+			}
+		}
+
+	default:
+		//	auto levels are round values strictly inside the interval (the extremes would be lines of a single point)
+		count := levels.Count
+		if count <= 0 {
+			count = DEFAULT_CONTOUR_LEVELS
+		}
+		if max <= min {
+			break
+		}
+
+		step := niceStep((max - min) / float64(count))
+
+		for i := math.Floor(min / step); i*step <= max; i++ {
+			value := roundToStep(i*step, step)
+			if value > min && value < max {
+				level = append(level, value)
+			}
+		}
+	}
+
+	return level
+}
+
+//	edge of a cell of the grid, from the point (i, j) to the next column or to the next row
+type gridEdge struct {
+	i          int
+	j          int
+	horizontal bool
+}
+
+//	ContourPaths trace the contour lines of each level in a grid of values, where value[j][i] is the value at
+//	(column[i], row[j]) and the values that are not finite are left out of the lines
+func ContourPaths(column, row []float64, value [][]float64, levels []float64) []Contour_path {
+
+	var path []Contour_path
+
+	for _, level := range levels {
+		path = append(path, levelPaths(column, row, value, level)...)
+	}
+
+	return path
+}
+
+//	levelPaths trace the contour lines of a level using marching squares
+func levelPaths(column, row []float64, value [][]float64, level float64) []Contour_path {
+
+	finite := func(v float64) bool {
+		return !math.IsNaN(v) && !math.IsInf(v, 0)
+	}
+
+	//	the point where the contour line crosses an edge is interpolated between it's ends
+	crossing := func(edge gridEdge) Point_2d {
+		i2, j2 := edge.i, edge.j+1
+		if edge.horizontal {
+			i2, j2 = edge.i+1, edge.j
+		}
+
+		v1, v2 := value[edge.j][edge.i], value[j2][i2]
+		fraction := (level - v1) / (v2 - v1)
+
+		return Point_2d{
+			X: column[edge.i] + fraction*(column[i2]-column[edge.i]),
+			Y: row[edge.j] + fraction*(row[j2]-row[edge.j]),
+		}
+	}
+
+	//	each cell has zero, one or two segments of the contour line joining the crossed edges
+	var segment [][2]gridEdge
+
+	for j := 0; j+1 < len(row); j++ {
+		for i := 0; i+1 < len(column); i++ {
+			corner := [4]float64{value[j][i], value[j][i+1], value[j+1][i+1], value[j+1][i]}
+			if !finite(corner[0]) || !finite(corner[1]) || !finite(corner[2]) || !finite(corner[3]) {
+				continue
+			}
+
+			//	edges in the order bottom, right, top and left, each one from corner k to corner k+1
+			edge := [4]gridEdge{{i, j, true}, {i + 1, j, false}, {i, j + 1, true}, {i, j, false}}
+
+			var crossed []gridEdge
+
+			for k := range edge {
+				if (corner[k] >= level) != (corner[(k+1)%4] >= level) {
+					crossed = append(crossed, edge[k])
+				}
+			}
+
+			switch len(crossed) {
+			case 2:
+				segment = append(segment, [2]gridEdge{crossed[0], crossed[1]})
+
+			case 4:
+				//	in a saddle the centre of the cell decides which corners are isolated by the contour line
+				centre := (corner[0] + corner[1] + corner[2] + corner[3]) / 4
+
+				if (corner[1] >= level) != (centre >= level) {
+					segment = append(segment, [2]gridEdge{edge[0], edge[1]}, [2]gridEdge{edge[2], edge[3]})
+				} else {
+					segment = append(segment, [2]gridEdge{edge[3], edge[0]}, [2]gridEdge{edge[1], edge[2]})
+				}
+			}
+		}
+	}
+
+	//	the segments are joined by their shared edges, which are crossed by at most two segments
+	touching := make(map[gridEdge][]int)
+	for s, ends := range segment {
+		touching[ends[0]] = append(touching[ends[0]], s)
+		touching[ends[1]] = append(touching[ends[1]], s)
+	}
+
+	used := make([]bool, len(segment))
+
+	var path []Contour_path
+
+	for s := range segment {
+		if used[s] {
+			continue
+		}
+		used[s] = true
+
+		forward := walkContour(segment, touching, used, segment[s][1])
+		backward := walkContour(segment, touching, used, segment[s][0])
+
+		chain := make([]gridEdge, 0, len(forward)+len(backward)+2)
+		for k := len(backward) - 1; k >= 0; k-- {
+			chain = append(chain, backward[k])
+		}
+		chain = append(chain, segment[s][0], segment[s][1])
+		chain = append(chain, forward...)
+
+		point := make([]Point_2d, len(chain))
+		for k, edge := range chain {
+			point[k] = crossing(edge)
+		}
+
+		path = append(path, Contour_path{Level: level, Point: point})
+	}
+
+	return path
+}
+
+//	walkContour follow the segments not used yet from an edge, returning the edges crossed along the way
+func walkContour(segment [][2]gridEdge, touching map[gridEdge][]int, used []bool, from gridEdge) []gridEdge {
+
+	var chain []gridEdge
+
+	for {
+		next := -1
+		for _, s := range touching[from] {
+			if !used[s] {
+				next = s
+				break
+			}
+		}
+		if next < 0 {
+			return chain
+		}
+		used[next] = true
+
+		if segment[next][0] == from {
+			from = segment[next][1]
+		} else {
+			from = segment[next][0]
+		}
+		chain = append(chain, from)
+	}
+}
+
+//	Contours return the contour lines of the levels for a uniform grid of points, whose values are in their Colour
+//	(as in images)
+func (set *Set_points_2d) Contours(levels *Contour_levels) ([]Contour_path, error) {
+
+	err := levels.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	column, row := set.imageGrid()
+	if len(column)*len(row) != len(set.Point) || len(column) < 2 || len(row) < 2 {
+		return nil, errors.New("contours require a single value for each point of a grid: " + set.Title)
+	}
+
+	columnIndex := make(map[float64]int)
+	for i, x := range column {
+		columnIndex[x] = i
+	}
+	rowIndex := make(map[float64]int)
+	for j, y := range row {
+		rowIndex[y] = j
+	}
+
+	//	points without a value are left out of the contour lines
+	value := make([][]float64, len(row))
+	for j := range value {
+		value[j] = make([]float64, len(column))
+		for i := range value[j] {
+			value[j][i] = math.NaN()
+		}
+	}
+
+	var z_range axisRange
+
+	for _, point := range set.Point {
+		if point.Colour == nil || math.IsNaN(*point.Colour) || math.IsInf(*point.Colour, 0) {
+			continue
+		}
+
+		value[rowIndex[point.Y]][columnIndex[point.X]] = *point.Colour
+		z_range.extend(*point.Colour, *point.Colour)
+	}
+	if !z_range.used {
+		return nil, nil
+	}
+
+	return ContourPaths(column, row, value, levels.Levels(z_range.min, z_range.max)), nil
+}
+
+//	sampleFunctionGrid evaluate a function of x and y for each point of a grid with the informed number of columns and
+//	rows, keeping the value in the Colour of the point (points where the function is not finite have no value)
+func sampleFunctionGrid(function string, angles uint8, min_x, max_x, min_y, max_y float64, columns, rows int) ([]Point_2d, error) {
+
+	if columns < 2 || rows < 2 {
+		return nil, errors.New("a grid of samples requires at least two columns and two rows")
+	}
+	if min_x >= max_x || min_y >= max_y {
+		return nil, errors.New("invalid range of samples: " + function)
+	}
+
+	functionExpr, err := expression.NewExpression(function)
+	if err != nil {
+		return nil, errors.New("error parsing function to be plotted: " + err.Error())
+	}
+
+	//	create the symbol table
+	symbolTable := expression.NewFloatSymbolTable()
+
+	expression.AddStandardMathFuncs(symbolTable)
+	expression.SetAngleUnit(symbolTable, angles)
+
+	point := make([]Point_2d, 0, columns*rows)
+
+	for j := 0; j < rows; j++ {
+		y := min_y + (max_y-min_y)*float64(j)/float64(rows-1)
+
+		for i := 0; i < columns; i++ {
+			x := min_x + (max_x-min_x)*float64(i)/float64(columns-1)
+
+			symbolTable.SetValue("x", x)
+			symbolTable.SetValue("y", y)
+
+			value, err := functionExpr.Evaluate(symbolTable)
+			if err != nil {
+				return nil, errors.New("error evaluating function to be plotted: " + err.Error())
+			}
+
+			sample := Point_2d{X: x, Y: y}
+			if !math.IsNaN(value) && !math.IsInf(value, 0) {
+				sample.Colour = &value
+			}
+			point = append(point, sample)
+		}
+	}
+
+	return point, nil
+}
+
+//	NewContourFunction create a set with the values of a function of x and y for each point of a grid with the
+//	informed number of columns and rows, plotted as contour lines
+func NewContourFunction(function string, min_x, max_x, min_y, max_y float64, columns, rows int) (*Set_points_2d, error) {
+
+	point, err := sampleFunctionGrid(function, expression.RADIANS, min_x, max_x, min_y, max_y, columns, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Set_points_2d{
+		Title: function,
+		Style: CONTOURS,
+		Point: point,
+	}, nil
+}
+
+//	isoSamples return the number of columns and rows of the grid used to sample functions of x and y, using the
+//	default ones when not informed
+func isoSamples(columns, rows int) (int, int) {
+
+	if columns <= 0 {
+		columns = DEFAULT_ISOSAMPLES
+	}
+	if rows <= 0 {
+		rows = columns
+	}
+
+	return columns, rows
+}
+
+//	generateContours generate the contour lines of the set, coloured by their level when the line colour is the
+//	palette, and a label with the level in the middle of each line
+func (set *Set_points_2d) generateContours(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	path, err := set.Contours(&set.contour.Levels)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[warning] %s\n", err.Error())
+		return
+	}
+
+	pathColour := func(level float64) RGB_colour {
+		if set.colours != nil && set.Line_style.Colour_source == COLOUR_PALETTE {
+			return set.colours.colour(level)
+		}
+		return colour
+	}
+
+	for _, line := range path {
+		driver.BeginPath(pathColour(line.Level))
+		for _, point := range line.Point {
+			driver.PointToPath(int64(x_scale.scale(point.X)), int64(y_scale.scale(point.Y)))
+		}
+		driver.EndPath()
+	}
+
+	if set.contour.No_labels {
+		return
+	}
+
+	//	the labels are drawn over a blank box, after all lines
+	for _, line := range path {
+		label := strconv.FormatFloat(line.Level, 'g', 6, 64)
+		textWidth, textHeight := driver.GetTextBox(label)
+
+		x, y, length := contourMiddle(&line, x_scale, y_scale)
+		if length < 2*float64(textWidth) {
+			continue
+		}
+
+		left := int64(x) - textWidth/2 - CONTOUR_LABEL_MARGIN
+		right := int64(x) + textWidth/2 + CONTOUR_LABEL_MARGIN
+		bottom := int64(y) - textHeight/2 - CONTOUR_LABEL_MARGIN
+		top := int64(y) + textHeight/2 + CONTOUR_LABEL_MARGIN
+
+		driver.FillPolygon([]DriverPoint{{X: left, Y: bottom}, {X: right, Y: bottom}, {X: right, Y: top}, {X: left, Y: top}}, WHITE, Fill_style{})
+		driver.Text(int64(x)-textWidth/2, int64(y)-textHeight/2, 0, label, pathColour(line.Level))
+	}
+}
+
+//	contourMiddle return the point of a contour line in the middle of it's length, and the length, in driver
+//	coordinates
+func contourMiddle(line *Contour_path, x_scale, y_scale *axisScale) (float64, float64, float64) {
+
+	scaled := make([][2]float64, len(line.Point))
+	for i, point := range line.Point {
+		scaled[i] = [2]float64{x_scale.scale(point.X), y_scale.scale(point.Y)}
+	}
+
+	var length float64
+	for i := 1; i < len(scaled); i++ {
+		length += math.Hypot(scaled[i][0]-scaled[i-1][0], scaled[i][1]-scaled[i-1][1])
+	}
+
+	half := length / 2
+	for i := 1; i < len(scaled); i++ {
+		step := math.Hypot(scaled[i][0]-scaled[i-1][0], scaled[i][1]-scaled[i-1][1])
+		if step >= half && step > 0 {
+			fraction := half / step
+
+			return scaled[i-1][0] + fraction*(scaled[i][0]-scaled[i-1][0]), scaled[i-1][1] + fraction*(scaled[i][1]-scaled[i-1][1]), length
+		}
+		half -= step
+	}
+
+	return scaled[0][0], scaled[0][1], length
+}
+
+//	parseContourParam parse the options of a set cntrparam command: levels [auto] [n] | levels discrete z1 [,z2 ...] |
+//	levels incremental start, increment [, end]
+func parseContourParam(levels *Contour_levels, options string) error {
+
+	levelsRegEx, err := regexp.Compile(`^\s*levels(\s+|$)((auto|discrete|incremental)(\s+|$)){0,1}(.*?)\s*$`)
+	if err != nil {
+		return err
+	}
+
+	match := levelsRegEx.FindAllStringSubmatch(options, -1)
+	if len(match) != 1 {
+		return errors.New("invalid contour parameter: " + strings.TrimSpace(options))
+	}
+
+	var value []float64
+
+	if len(match[0][5]) > 0 {
+		for _, item := range strings.Split(match[0][5], ",") {
+			number, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+			if err != nil {
+				return errors.New("contour level expected to be numeric: " + strings.TrimSpace(item))
+			}
+			value = append(value, number)
+		}
+	}
+
+	var contourLevels Contour_levels
+
+	switch Contour_levels_kind[match[0][3]] {
+	case LEVELS_DISCRETE:
+		contourLevels = Contour_levels{Kind: LEVELS_DISCRETE, Values: value}
+
+	case LEVELS_INCREMENTAL:
+		if len(value) < 2 || len(value) > 3 {
+			return errors.New("incremental contour levels require start, increment and an optional end: " + match[0][5])
+		}
+
+		contourLevels = Contour_levels{Kind: LEVELS_INCREMENTAL, Start: value[0], Increment: value[1]}
+		if len(value) == 3 {
+			contourLevels.End = &value[2]
+		}
+
+	default:
+		if len(value) > 1 || (len(value) == 1 && value[0] != math.Trunc(value[0])) {
+			return errors.New("number of contour levels expected to be an integer: " + match[0][5])
+		}
+
+		contourLevels = Contour_levels{Kind: LEVELS_AUTO}
+		if len(value) == 1 {
+			contourLevels.Count = int(value[0])
+		}
+	}
+
+	err = contourLevels.Validate()
+	if err != nil {
+		return err
+	}
+	*levels = contourLevels
+
+	return nil
+}
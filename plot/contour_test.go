@@ -0,0 +1,174 @@
+////////////////////////////////////////////////////////////////////////////////
+//	contour_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the contour lines
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//	TestContour unit tests for the contour lines
+func TestContour(t *testing.T) {
+
+	t.Run(">>> Levels: auto, discrete and incremental", func(t *testing.T) {
+
+		end := 0.9
+
+		for _, test := range []struct {
+			levels Contour_levels
+			want   []float64
+		}{
+			{levels: Contour_levels{}, want: []float64{2, 4, 6, 8}},
+			{levels: Contour_levels{Kind: LEVELS_AUTO, Count: 2}, want: []float64{5}},
+			{levels: Contour_levels{Kind: LEVELS_DISCRETE, Values: []float64{-1, 3, 12}}, want: []float64{3}},
+			{levels: Contour_levels{Kind: LEVELS_INCREMENTAL, Start: 0.3, Increment: 0.2, End: &end}, want: []float64{0.3, 0.5, 0.7, 0.9}},
+		} {
+			got := test.levels.Levels(0, 10)
+			//	check the result
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("failed evaluating contour levels: expected: %v result: %v", test.want, got)
+			}
+		}
+	})
+
+	t.Run(">>> ContourPaths: closed line around a peak", func(t *testing.T) {
+
+		value := [][]float64{{0, 0, 0}, {0, 4, 0}, {0, 0, 0}}
+
+		got := ContourPaths([]float64{0, 1, 2}, []float64{0, 1, 2}, value, []float64{2})
+		//	check the result
+		if len(got) != 1 || !got[0].Closed() || len(got[0].Point) != 5 {
+			t.Errorf("failed tracing contour lines: expected a closed line of 4 segments result: %v", got)
+			return
+		}
+		for _, point := range got[0].Point {
+			if math.Abs(point.X-1)+math.Abs(point.Y-1) != 0.5 {
+				t.Errorf("failed tracing contour lines: expected points half way to the peak result: %v", point)
+			}
+		}
+	})
+
+	t.Run(">>> ContourPaths: open line across a ramp without the undefined cells", func(t *testing.T) {
+
+		value := [][]float64{{0, 1, 2, 3}, {0, 1, 2, math.NaN()}}
+
+		got := ContourPaths([]float64{0, 1, 2, 3}, []float64{0, 1}, value, []float64{0.5, 2.5})
+		//	check the result
+		if len(got) != 1 || got[0].Closed() || got[0].Level != 0.5 {
+			t.Errorf("failed tracing contour lines: expected a single line at 0.5 result: %v", got)
+			return
+		}
+		if got[0].Point[0].X != 0.5 || got[0].Point[1].X != 0.5 {
+			t.Errorf("failed tracing contour lines: expected a vertical line at x = 0.5 result: %v", got[0].Point)
+		}
+	})
+
+	t.Run(">>> ContourPaths: saddle", func(t *testing.T) {
+
+		//	the centre of the saddle is above the level, joining the corners above it
+		value := [][]float64{{3, 0}, {0, 3}}
+
+		got := ContourPaths([]float64{0, 1}, []float64{0, 1}, value, []float64{1})
+		//	check the result
+		if len(got) != 2 {
+			t.Errorf("failed tracing contour lines: expected two lines result: %v", got)
+			return
+		}
+		for _, line := range got {
+			middle_x := (line.Point[0].X + line.Point[1].X) / 2
+			middle_y := (line.Point[0].Y + line.Point[1].Y) / 2
+			if math.Hypot(middle_x-1, middle_y) > 0.5 && math.Hypot(middle_x, middle_y-1) > 0.5 {
+				t.Errorf("failed tracing contour lines: expected lines around the corners below the level result: %v", line.Point)
+			}
+		}
+	})
+
+	t.Run(">>> Contours: incomplete grid", func(t *testing.T) {
+
+		value := 1.0
+		set := Set_points_2d{Title: "grid", Point: []Point_2d{{X: 0, Y: 0, Colour: &value}, {X: 1, Y: 1, Colour: &value}}}
+
+		want := "contours require a single value for each point of a grid: grid"
+		_, err := set.Contours(&Contour_levels{})
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed tracing contour lines: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> NewContourFunction: function sampled in a grid", func(t *testing.T) {
+
+		set, err := NewContourFunction("x*y", 0, 2, 0, 1, 3, 2)
+		if err != nil {
+			t.Errorf("fail creating the contour function: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if len(set.Point) != 6 || set.Style != CONTOURS {
+			t.Errorf("failed creating the contour function: expected: 6 points result: %d", len(set.Point))
+			return
+		}
+		if got := set.Point[5]; got.X != 2 || got.Y != 1 || got.Colour == nil || *got.Colour != 2 {
+			t.Errorf("failed creating the contour function: expected value 2 at 2,1 result: %v", got)
+		}
+	})
+
+	t.Run(">>> parseContourParam: levels", func(t *testing.T) {
+
+		for _, test := range []struct {
+			options string
+			want    Contour_levels
+		}{
+			{options: "levels 8", want: Contour_levels{Kind: LEVELS_AUTO, Count: 8}},
+			{options: "levels auto", want: Contour_levels{Kind: LEVELS_AUTO}},
+			{options: "levels discrete 1, 2.5,4", want: Contour_levels{Kind: LEVELS_DISCRETE, Values: []float64{1, 2.5, 4}}},
+			{options: "levels incremental -1, 0.5", want: Contour_levels{Kind: LEVELS_INCREMENTAL, Start: -1, Increment: 0.5}},
+		} {
+			var got Contour_levels
+
+			err := parseContourParam(&got, test.options)
+			//	check the result
+			if err != nil || !reflect.DeepEqual(test.want, got) {
+				t.Errorf("failed parsing contour parameters %s: expected: %v result: %v %v", test.options, test.want, got, err)
+			}
+		}
+
+		for _, options := range []string{"cubicspline", "levels incremental 1", "levels 2.5", "levels discrete"} {
+			var got Contour_levels
+
+			err := parseContourParam(&got, options)
+			//	check the result
+			if err == nil {
+				t.Errorf("failed parsing contour parameters %s: expected an error result: %v", options, got)
+			}
+		}
+	})
+
+	t.Run(">>> generateContours: lines and labels", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set, _ := NewContourFunction("x", 0, 10, 0, 10, 3, 3)
+		set.contour = Contour_style{Levels: Contour_levels{Kind: LEVELS_DISCRETE, Values: []float64{5}}}
+		set.generateContours(driver, newAxisScale(&Axis{}, 0, 10, 0, 100), newAxisScale(&Axis{}, 0, 10, 0, 100), RED)
+		writer.Flush()
+
+		//	check the result
+		if strings.Count(output.String(), "<path") != 1 || !strings.Contains(output.String(), ">5</text>") {
+			t.Errorf("failed generating contours: expected a line with it's label result: %s", output.String())
+		}
+	})
+}
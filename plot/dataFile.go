@@ -16,7 +16,118 @@ import (
 
 //	LoadDataFile load a data file and return a Plot
 func LoadDataFile(x_column uint8, y_column uint8, reader *bufio.Reader) ([]Point_2d, error) {
-	point := make([]Point_2d, 0, 10)
+
+	row, err := LoadDataColumns([]uint8{x_column, y_column}, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	point := make([]Point_2d, len(row))
+
+	for i := range row {
+		point[i] = Point_2d{X: row[i][0], Y: row[i][1]}
+	}
+
+	return point, nil
+}
+
+//	LoadDataColumns load the values of a list of columns from each line of a data file (column 0 is the line number)
+func LoadDataColumns(data_column []uint8, reader *bufio.Reader) ([][]float64, error) {
+	row := make([][]float64, 0, 10)
+
+	err := readDataFile(reader, func(line string, column []string) error {
+
+		//	check if the line have the expected columns
+		for _, index := range data_column {
+			if len(column) < int(index) {
+				return errors.New(`line with less columns than expected: "` + line + `"`)
+			}
+		}
+
+		//	check if the columns are numeric
+		values := make([]float64, len(data_column))
+
+		for i, index := range data_column {
+			if index == 0 {
+				values[i] = float64(len(row))
+				continue
+			}
+
+			var err error
+
+			values[i], err = strconv.ParseFloat(column[index-1], 64)
+			if err != nil {
+				return errors.New(`column ` + fmt.Sprintf("%d", index) + ` expected to be numeric: "` + line + `"`)
+			}
+		}
+
+		//	add the new row
+		row = append(row, values)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+//	LoadDataMatrix load the values of a uniform matrix, where every line of a data file is a row with the same number
+//	of columns
+func LoadDataMatrix(reader *bufio.Reader) ([][]float64, error) {
+	row := make([][]float64, 0, 10)
+
+	err := readDataFile(reader, func(line string, column []string) error {
+
+		if len(row) > 0 && len(column) != len(row[0]) {
+			return errors.New(`matrix line with a different number of columns: "` + line + `"`)
+		}
+
+		values := make([]float64, len(column))
+
+		for i := range column {
+			var err error
+
+			values[i], err = strconv.ParseFloat(column[i], 64)
+			if err != nil {
+				return errors.New(`matrix value expected to be numeric: "` + line + `"`)
+			}
+		}
+
+		row = append(row, values)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+//	LoadDataLabels load the text of a column from each line of a data file
+func LoadDataLabels(label_column uint8, reader *bufio.Reader) ([]string, error) {
+	label := make([]string, 0, 10)
+
+	err := readDataFile(reader, func(line string, column []string) error {
+		if label_column == 0 || len(column) < int(label_column) {
+			return errors.New(`line with less columns than expected: "` + line + `"`)
+		}
+
+		label = append(label, strings.Trim(column[label_column-1], `"`))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+//	readDataFile read a data file line by line, calling the handler with the columns of each line after the header
+func readDataFile(reader *bufio.Reader, handler func(line string, column []string) error) error {
 
 	//	read the input line by line
 	var line string
@@ -60,28 +171,14 @@ func LoadDataFile(x_column uint8, y_column uint8, reader *bufio.Reader) ([]Point
 				column = append(column, value)
 			}
 
-			//	check if the line have the expected columns
-			if len(column) < int(x_column) || len(column) < int(y_column) {
-				return nil, errors.New(`line with less columns than expected: "` + line + `"`)
-			}
-
-			//	check if the columns are numeric
-			x, err := strconv.ParseFloat(column[x_column-1], 64)
+			err = handler(line, column)
 			if err != nil {
-				return nil, errors.New(`column ` + fmt.Sprintf("%d", x_column) + ` expected to be numeric: "` + line + `"`)
+				return err
 			}
 
-			y, err := strconv.ParseFloat(column[y_column-1], 64)
-			if err != nil {
-				return nil, errors.New(`column ` + fmt.Sprintf("%d", y_column) + ` expected to be numeric: "` + line + `"`)
-			}
-
-			//	add the new point
-			point = append(point, Point_2d{X: x, Y: y})
-
 			line = ""
 		}
 	}
 
-	return point, nil
+	return nil
 }
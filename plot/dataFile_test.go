@@ -8,6 +8,7 @@ package plot
 
 import (
 	"bufio"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -163,4 +164,62 @@ func TestLoadDataFile(t *testing.T) {
 			t.Errorf("failed parsing data file: error expected: '%s' result: '%s'", want, got)
 		}
 	})
+
+	t.Run(">>> LoadDataColumns: column 0 is the line number", func(t *testing.T) {
+
+		mockDataFile := strings.NewReader("col1 col2\n10 20\n40 50\n")
+		column, err := LoadDataColumns([]uint8{0, 2}, bufio.NewReader(mockDataFile))
+		if err != nil {
+			t.Errorf("fail loading data file: %s", err.Error())
+			return
+		}
+
+		want := [][]float64{{0, 20}, {1, 50}}
+		//	check the result
+		if !reflect.DeepEqual(want, column) {
+			t.Errorf("failed parsing data file: expected: %v result: %v", want, column)
+		}
+	})
+
+	t.Run(">>> LoadDataLabels: quoted labels", func(t *testing.T) {
+
+		mockDataFile := strings.NewReader("week cpu\n\"w1\" 20\nw2 50\n")
+		label, err := LoadDataLabels(1, bufio.NewReader(mockDataFile))
+		if err != nil {
+			t.Errorf("fail loading data file: %s", err.Error())
+			return
+		}
+
+		want := []string{"w1", "w2"}
+		//	check the result
+		if !reflect.DeepEqual(want, label) {
+			t.Errorf("failed parsing data file: expected: %v result: %v", want, label)
+		}
+	})
+
+	t.Run(">>> LoadDataMatrix: uniform matrix", func(t *testing.T) {
+
+		mockDataFile := strings.NewReader("# matrix\n1 2 3\n4 5 6\n")
+		value, err := LoadDataMatrix(bufio.NewReader(mockDataFile))
+		if err != nil {
+			t.Errorf("fail loading data file: %s", err.Error())
+			return
+		}
+
+		want := [][]float64{{1, 2, 3}, {4, 5, 6}}
+		//	check the result
+		if !reflect.DeepEqual(want, value) {
+			t.Errorf("failed parsing data file: expected: %v result: %v", want, value)
+		}
+	})
+
+	t.Run(">>> LoadDataMatrix: rows with different lengths", func(t *testing.T) {
+
+		mockDataFile := strings.NewReader("# matrix\n1 2 3\n4 5\n")
+		_, err := LoadDataMatrix(bufio.NewReader(mockDataFile))
+		//	check the result
+		if err == nil || !strings.HasPrefix(err.Error(), "matrix line with a different number of columns") {
+			t.Errorf("failed parsing data file: expected an error for the short row result: %v", err)
+		}
+	})
 }
@@ -0,0 +1,116 @@
+////////////////////////////////////////////////////////////////////////////////
+//	errorBars.go  -  Oct-19-2026  -  aldebap
+//
+//	Error bar styles of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+//	width in pixels of the end caps of error bars with the default size
+const (
+	ERROR_BAR_WIDTH = 8
+)
+
+//	error extents of a 2D point (absolute limits of the point's uncertainty)
+type Error_2d struct {
+	X_low  float64
+	X_high float64
+	Y_low  float64
+	Y_high float64
+}
+
+//	barSize return the size of the end caps of error bars (1 when not informed, 0 without caps)
+func (p *Plot_2D) barSize() float64 {
+	if p.Bar_size == nil {
+		return 1
+	}
+
+	return *p.Bar_size
+}
+
+//	newError2D create the error extents of a point from the columns that follow x and y (delta or low and high)
+func newError2D(style uint8, x, y float64, column []float64) *Error_2d {
+
+	if len(column) == 0 {
+		return nil
+	}
+
+	point_error := &Error_2d{X_low: x, X_high: x, Y_low: y, Y_high: y}
+
+	switch style {
+	case X_ERROR_BARS:
+		if len(column) == 1 {
+			point_error.X_low, point_error.X_high = x-column[0], x+column[0]
+		} else {
+			point_error.X_low, point_error.X_high = column[0], column[1]
+		}
+
+	case XY_ERROR_BARS:
+		if len(column) == 2 {
+			point_error.X_low, point_error.X_high = x-column[0], x+column[0]
+			point_error.Y_low, point_error.Y_high = y-column[1], y+column[1]
+		} else {
+			point_error.X_low, point_error.X_high = column[0], column[1]
+			point_error.Y_low, point_error.Y_high = column[2], column[3]
+		}
+
+	default:
+		if len(column) == 1 {
+			point_error.Y_low, point_error.Y_high = y-column[0], y+column[0]
+		} else {
+			point_error.Y_low, point_error.Y_high = column[0], column[1]
+		}
+	}
+
+	return point_error
+}
+
+//	hasMarkers check if the style of the set draw a marker for each point
+func (set *Set_points_2d) hasMarkers() bool {
+
+	switch set.Style {
+	case POINTS, LINES_POINTS, Y_ERROR_BARS, X_ERROR_BARS, XY_ERROR_BARS, Y_ERROR_LINES:
+		return true
+	}
+
+	return false
+}
+
+//	generateErrorBars generate the error bars of each point of the set, with end caps of the informed size
+func (set *Set_points_2d) generateErrorBars(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	capWidth := ERROR_BAR_WIDTH * set.barSize / 2
+
+	for _, point := range set.Point {
+		if point.Error == nil {
+			continue
+		}
+
+		scaled_x := x_scale.scale(point.X)
+		scaled_y := y_scale.scale(point.Y)
+
+		//	vertical bars for the y error extents
+		if point.Error.Y_low != point.Error.Y_high {
+			scaled_low := y_scale.scale(point.Error.Y_low)
+			scaled_high := y_scale.scale(point.Error.Y_high)
+
+			driver.Line(int64(scaled_x), int64(scaled_low), int64(scaled_x), int64(scaled_high), colour)
+			if capWidth > 0 {
+				driver.Line(int64(scaled_x-capWidth), int64(scaled_low), int64(scaled_x+capWidth), int64(scaled_low), colour)
+				driver.Line(int64(scaled_x-capWidth), int64(scaled_high), int64(scaled_x+capWidth), int64(scaled_high), colour)
+			}
+		}
+
+		//	horizontal bars for the x error extents
+		if point.Error.X_low != point.Error.X_high {
+			scaled_low := x_scale.scale(point.Error.X_low)
+			scaled_high := x_scale.scale(point.Error.X_high)
+
+			driver.Line(int64(scaled_low), int64(scaled_y), int64(scaled_high), int64(scaled_y), colour)
+			if capWidth > 0 {
+				driver.Line(int64(scaled_low), int64(scaled_y-capWidth), int64(scaled_low), int64(scaled_y+capWidth), colour)
+				driver.Line(int64(scaled_high), int64(scaled_y-capWidth), int64(scaled_high), int64(scaled_y+capWidth), colour)
+			}
+		}
+	}
+}
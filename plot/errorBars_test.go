@@ -0,0 +1,55 @@
+////////////////////////////////////////////////////////////////////////////////
+//	errorBars_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for error bar styles
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"reflect"
+	"testing"
+)
+
+//	TestErrorBars unit tests for error extents of points
+func TestErrorBars(t *testing.T) {
+
+	t.Run(">>> newError2D: delta and low/high columns", func(t *testing.T) {
+
+		want := []*Error_2d{
+			{X_low: 2, X_high: 2, Y_low: 9, Y_high: 11},
+			{X_low: 1.5, X_high: 2.5, Y_low: 10, Y_high: 10},
+			{X_low: 1, X_high: 3, Y_low: 8, Y_high: 12},
+			nil,
+		}
+		got := []*Error_2d{
+			newError2D(Y_ERROR_BARS, 2, 10, []float64{1}),
+			newError2D(X_ERROR_BARS, 2, 10, []float64{1.5, 2.5}),
+			newError2D(XY_ERROR_BARS, 2, 10, []float64{1, 2}),
+			newError2D(POINTS, 2, 10, nil),
+		}
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed creating error extents: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> getMinMax: error extents in the autoscaled range", func(t *testing.T) {
+
+		set := Set_points_2d{
+			Style: XY_ERROR_BARS,
+			Point: []Point_2d{
+				{X: 1, Y: 5, Error: &Error_2d{X_low: 0.5, X_high: 1.5, Y_low: 2, Y_high: 6}},
+				{X: 3, Y: 7, Error: &Error_2d{X_low: 2, X_high: 4, Y_low: 6, Y_high: 9}},
+			},
+		}
+
+		want := []float64{0.5, 2, 4, 9}
+		min_x, min_y, max_x, max_y, err := set.getMinMax()
+		got := []float64{min_x, min_y, max_x, max_y}
+		//	check the result
+		if err != nil || !reflect.DeepEqual(want, got) {
+			t.Errorf("failed evaluating min-max: expected: %v result: %v %v", want, got, err)
+		}
+	})
+}
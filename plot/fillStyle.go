@@ -0,0 +1,207 @@
+////////////////////////////////////////////////////////////////////////////////
+//	fillStyle.go  -  Oct-19-2026  -  aldebap
+//
+//	Fill styles used to paint the interior of areas
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+//	types of fill
+const (
+	FILL_SOLID   uint8 = 0
+	FILL_PATTERN uint8 = 1
+	FILL_EMPTY   uint8 = 2
+)
+
+//	fill patterns (0 empty, 1 crosshatch, 2 dense crosshatch, 3 solid, 4 diagonal /, 5 diagonal \, 6 horizontal, 7 vertical)
+const (
+	PATTERN_SPACING       = 8
+	PATTERN_DENSE_SPACING = 4
+	PATTERN_COUNT         = 8
+)
+
+//	names of the types of fill
+var Fill_type = map[string]uint8{
+	"solid":   FILL_SOLID,
+	"pattern": FILL_PATTERN,
+	"empty":   FILL_EMPTY,
+}
+
+//	attributes used to describe the fill of an area (a zero density means a full solid fill)
+type Fill_style struct {
+	Type        uint8
+	Density     float64
+	Pattern     uint8
+	Transparent bool
+}
+
+//	patternLines line families of a fill pattern
+type patternLines struct {
+	spacing    int64
+	slash      bool
+	backslash  bool
+	horizontal bool
+	vertical   bool
+}
+
+//	density return the density of a solid fill
+func (fill *Fill_style) density() float64 {
+	if fill.Density <= 0 || fill.Density > 1 {
+		return 1
+	}
+
+	return fill.Density
+}
+
+//	solidColour return the colour of a solid fill, mixed with the background when it's not transparent
+func (fill *Fill_style) solidColour(colour, background RGB_colour) RGB_colour {
+	if fill.Transparent {
+		return colour
+	}
+
+	density := fill.density()
+	mix := func(c, b uint8) uint8 {
+		return uint8(float64(b) + density*(float64(c)-float64(b)) + 0.5)
+	}
+
+	return RGB_colour{red: mix(colour.red, background.red), green: mix(colour.green, background.green), blue: mix(colour.blue, background.blue)}
+}
+
+//	opacity return the opacity of a solid fill
+func (fill *Fill_style) opacity() float64 {
+	if fill.Transparent {
+		return fill.density()
+	}
+
+	return 1
+}
+
+//	lines return the line families of the fill pattern (patterns 0 and 3 have none, as they are empty and solid)
+func (fill *Fill_style) lines() patternLines {
+
+	switch fill.Pattern % PATTERN_COUNT {
+	case 1:
+		return patternLines{spacing: PATTERN_SPACING, slash: true, backslash: true}
+
+	case 2:
+		return patternLines{spacing: PATTERN_DENSE_SPACING, slash: true, backslash: true}
+
+	case 4:
+		return patternLines{spacing: PATTERN_SPACING, slash: true}
+
+	case 5:
+		return patternLines{spacing: PATTERN_SPACING, backslash: true}
+
+	case 6:
+		return patternLines{spacing: PATTERN_SPACING, horizontal: true}
+
+	case 7:
+		return patternLines{spacing: PATTERN_SPACING, vertical: true}
+	}
+
+	return patternLines{spacing: PATTERN_SPACING}
+}
+
+//	effectiveType return the type of fill, as patterns 0 and 3 are the same as empty and solid fills
+func (fill *Fill_style) effectiveType() uint8 {
+	if fill.Type == FILL_PATTERN {
+		switch fill.Pattern % PATTERN_COUNT {
+		case 0:
+			return FILL_EMPTY
+
+		case 3:
+			return FILL_SOLID
+		}
+	}
+
+	return fill.Type
+}
+
+//	segments return the segments of the pattern's tile, in coordinates with the y axis pointing down
+func (lines *patternLines) segments() [][4]int64 {
+
+	var segment [][4]int64
+	s := lines.spacing
+
+	if lines.slash {
+		segment = append(segment, [4]int64{0, s, s, 0})
+	}
+	if lines.backslash {
+		segment = append(segment, [4]int64{0, 0, s, s})
+	}
+	if lines.horizontal {
+		segment = append(segment, [4]int64{0, s / 2, s, s / 2})
+	}
+	if lines.vertical {
+		segment = append(segment, [4]int64{s / 2, 0, s / 2, s})
+	}
+
+	return segment
+}
+
+//	contains check if a pixel, in coordinates with the y axis pointing down, is painted by the pattern
+func (lines *patternLines) contains(x, y int64) bool {
+	s := lines.spacing
+	mod := func(value int64) int64 {
+		return ((value % s) + s) % s
+	}
+
+	return (lines.slash && mod(x+y) == 0) || (lines.backslash && mod(x-y) == 0) ||
+		(lines.horizontal && mod(y) == s/2) || (lines.vertical && mod(x) == s/2)
+}
+
+//	parseFillStyle parse a fill style option: (fillstyle|fs) [transparent] (empty|solid [density]|pattern [n]) [transparent]
+func parseFillStyle(fill *Fill_style, options string) (int, error) {
+
+	fillStyleRegEx, err := regexp.Compile(`^\s*(fillstyle|fs)(\s+transparent){0,1}\s+(empty|solid|pattern)(\s+([0-9.]+)){0,1}(\s+transparent){0,1}\s*`)
+	if err != nil {
+		return 0, err
+	}
+
+	match := fillStyleRegEx.FindAllStringSubmatch(options, -1)
+	if len(match) != 1 {
+		return 0, nil
+	}
+
+	*fill = Fill_style{Transparent: len(match[0][2]) > 0 || len(match[0][6]) > 0}
+
+	switch match[0][3] {
+	case "empty":
+		fill.Type = FILL_EMPTY
+		if len(match[0][5]) > 0 {
+			return 0, errors.New("invalid fill style: " + match[0][0])
+		}
+
+	case "solid":
+		fill.Type = FILL_SOLID
+		if len(match[0][5]) > 0 {
+			fill.Density, err = strconv.ParseFloat(match[0][5], 64)
+			if err != nil || fill.Density > 1 {
+				return 0, errors.New("invalid fill density: " + match[0][5])
+			}
+
+			//	a solid fill without density is an empty fill
+			if fill.Density == 0 {
+				fill.Type = FILL_EMPTY
+			}
+		}
+
+	case "pattern":
+		fill.Type = FILL_PATTERN
+		if len(match[0][5]) > 0 {
+			pattern, err := strconv.ParseUint(match[0][5], 10, 8)
+			if err != nil {
+				return 0, errors.New("invalid fill pattern: " + match[0][5])
+			}
+			fill.Pattern = uint8(pattern)
+		}
+	}
+
+	return len(match[0][0]), nil
+}
@@ -0,0 +1,73 @@
+////////////////////////////////////////////////////////////////////////////////
+//	fillStyle_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for fill styles
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"testing"
+)
+
+//	TestFillStyle unit tests for the fill style parser and colours
+func TestFillStyle(t *testing.T) {
+
+	t.Run(">>> parseFillStyle: valid fill styles", func(t *testing.T) {
+
+		options := []string{"fs solid 0.25 transparent", "fillstyle transparent pattern 4", "fs solid 0", "fs solid"}
+		want := []Fill_style{
+			{Type: FILL_SOLID, Density: 0.25, Transparent: true},
+			{Type: FILL_PATTERN, Pattern: 4, Transparent: true},
+			{Type: FILL_EMPTY},
+			{Type: FILL_SOLID},
+		}
+
+		for i := range options {
+			var got Fill_style
+
+			length, err := parseFillStyle(&got, options[i])
+			//	check the result
+			if err != nil || length != len(options[i]) || want[i] != got {
+				t.Errorf("failed parsing fill style: %s expected: %v result: %v %d %v", options[i], want[i], got, length, err)
+			}
+		}
+	})
+
+	t.Run(">>> parseFillStyle: invalid density", func(t *testing.T) {
+		want := "invalid fill density: 1.5"
+
+		var fill Fill_style
+
+		_, err := parseFillStyle(&fill, "fs solid 1.5")
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing fill style: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> solidColour: density mixed with the background", func(t *testing.T) {
+
+		fill := Fill_style{Density: 0.5}
+
+		want := RGB_colour{red: 255, green: 128, blue: 128}
+		got := fill.solidColour(RED, WHITE)
+		//	check the result
+		if want != got {
+			t.Errorf("failed mixing fill colour: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> lines: pixels of a crosshatch pattern", func(t *testing.T) {
+
+		fill := Fill_style{Type: FILL_PATTERN, Pattern: 1}
+		lines := fill.lines()
+
+		want := []bool{true, true, false}
+		got := []bool{lines.contains(3, 5), lines.contains(-2, 6), lines.contains(1, 2)}
+		//	check the result
+		if want[0] != got[0] || want[1] != got[1] || want[2] != got[2] {
+			t.Errorf("failed checking pattern pixels: expected: %v result: %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+////////////////////////////////////////////////////////////////////////////////
+//	filledCurves.go  -  Oct-19-2026  -  aldebap
+//
+//	Filled curves style of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+//	references of the area filled by a curve (by default it's closed, or between columns when a second one is informed)
+const (
+	FILLED_DEFAULT uint8 = 0
+	FILLED_CLOSED  uint8 = 1
+	FILLED_X1      uint8 = 2
+	FILLED_X2      uint8 = 3
+	FILLED_Y       uint8 = 4
+)
+
+//	sides of the reference where the area is filled
+const (
+	FILLED_BOTH_SIDES uint8 = 0
+	FILLED_ABOVE      uint8 = 1
+	FILLED_BELOW      uint8 = 2
+)
+
+//	attributes used to describe the area filled by a curve (value is the y of the FILLED_Y reference)
+type Filled_curves struct {
+	Reference uint8
+	Value     float64
+	Side      uint8
+}
+
+//	generateFilledCurves fill the area between the points of the set and it's reference
+func (set *Set_points_2d) generateFilledCurves(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	if len(set.Point) < 2 {
+		return
+	}
+
+	reference := set.Filled_curves.Reference
+	if reference == FILLED_DEFAULT {
+		reference = FILLED_CLOSED
+		if set.Point[0].Y_to != nil {
+			reference = FILLED_DEFAULT
+		}
+	}
+
+	//	a closed curve is a polygon with all the points
+	if reference == FILLED_CLOSED {
+		polygon := make([]DriverPoint, len(set.Point))
+
+		for i, point := range set.Point {
+			polygon[i] = DriverPoint{X: int64(x_scale.scale(point.X)), Y: int64(clamp(y_scale.scale(point.Y), y_scale))}
+		}
+		driver.FillPolygon(polygon, colour, set.Fill)
+
+		return
+	}
+
+	//	get the reference for each point
+	x := make([]float64, 0, len(set.Point))
+	curve := make([]float64, 0, len(set.Point))
+	limit := make([]float64, 0, len(set.Point))
+
+	for i, point := range set.Point {
+		var value float64
+
+		switch reference {
+		case FILLED_X1:
+			value = y_scale.origin()
+
+		case FILLED_X2:
+			value = y_scale.max
+
+		case FILLED_Y:
+			value = set.Filled_curves.Value
+
+		default:
+			if point.Y_to == nil {
+				continue
+			}
+			value = *point.Y_to
+		}
+
+		//	when the curve crosses the reference, the crossing point is added to split the area
+		if i > 0 && len(x) > 0 {
+			previous := curve[len(curve)-1] - limit[len(limit)-1]
+			current := point.Y - value
+
+			if previous*current < 0 {
+				fraction := previous / (previous - current)
+
+				x = append(x, x[len(x)-1]+fraction*(point.X-x[len(x)-1]))
+				curve = append(curve, curve[len(curve)-1]+fraction*(point.Y-curve[len(curve)-1]))
+				limit = append(limit, curve[len(curve)-1])
+			}
+		}
+
+		x = append(x, point.X)
+		curve = append(curve, point.Y)
+		limit = append(limit, value)
+	}
+
+	//	the area in the other side of the reference is collapsed into it
+	for i := range curve {
+		if (set.Filled_curves.Side == FILLED_ABOVE && curve[i] < limit[i]) || (set.Filled_curves.Side == FILLED_BELOW && curve[i] > limit[i]) {
+			curve[i] = limit[i]
+		}
+	}
+
+	polygon := make([]DriverPoint, 0, 2*len(x))
+
+	for i := range x {
+		polygon = append(polygon, DriverPoint{X: int64(x_scale.scale(x[i])), Y: int64(clamp(y_scale.scale(curve[i]), y_scale))})
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		polygon = append(polygon, DriverPoint{X: int64(x_scale.scale(x[i])), Y: int64(clamp(y_scale.scale(limit[i]), y_scale))})
+	}
+
+	if len(polygon) >= 3 {
+		driver.FillPolygon(polygon, colour, set.Fill)
+	}
+}
+
+//	parseFilledCurves parse the options of the filledcurves style: [closed|x1|x2|y=value] [above|below]
+func parseFilledCurves(filledCurves *Filled_curves, options string) (int, error) {
+
+	filledCurvesOptionRegEx, err := regexp.Compile(`^\s*(closed|x1|x2|above|below|y\s*=\s*([-+]{0,1}[0-9.]+))(\s+|$)`)
+	if err != nil {
+		return 0, err
+	}
+
+	length := 0
+
+	for {
+		match := filledCurvesOptionRegEx.FindAllStringSubmatch(options[length:], -1)
+		if len(match) != 1 {
+			break
+		}
+
+		switch match[0][1] {
+		case "closed":
+			filledCurves.Reference = FILLED_CLOSED
+
+		case "x1":
+			filledCurves.Reference = FILLED_X1
+
+		case "x2":
+			filledCurves.Reference = FILLED_X2
+
+		case "above":
+			filledCurves.Side = FILLED_ABOVE
+
+		case "below":
+			filledCurves.Side = FILLED_BELOW
+
+		default:
+			filledCurves.Reference = FILLED_Y
+			filledCurves.Value, err = strconv.ParseFloat(match[0][2], 64)
+			if err != nil {
+				return 0, errors.New("invalid filledcurves reference: " + match[0][1])
+			}
+		}
+
+		length += len(match[0][0])
+	}
+
+	return length, nil
+}
@@ -0,0 +1,55 @@
+////////////////////////////////////////////////////////////////////////////////
+//	filledCurves_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the filled curves style
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestFilledCurves unit tests for the filled curves style
+func TestFilledCurves(t *testing.T) {
+
+	t.Run(">>> parseFilledCurves: reference and side", func(t *testing.T) {
+
+		var got Filled_curves
+
+		options := "above y=-2.5 title \"t\""
+		length, err := parseFilledCurves(&got, options)
+
+		want := Filled_curves{Reference: FILLED_Y, Value: -2.5, Side: FILLED_ABOVE}
+		//	check the result
+		if err != nil || want != got || options[length:] != "title \"t\"" {
+			t.Errorf("failed parsing filledcurves options: expected: %v result: %v %d %v", want, got, length, err)
+		}
+	})
+
+	t.Run(">>> generateFilledCurves: area above the reference split at the crossing", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set := Set_points_2d{
+			Style:         FILLED_CURVES,
+			Filled_curves: Filled_curves{Reference: FILLED_Y, Value: 0, Side: FILLED_ABOVE},
+			Point:         []Point_2d{{X: 0, Y: 10}, {X: 10, Y: -10}},
+		}
+		set.generateFilledCurves(driver, newAxisScale(&Axis{}, 0, 10, 0, 100), newAxisScale(&Axis{}, -10, 10, 0, 100), RED)
+		writer.Flush()
+
+		want := `<polygon points="0,0 50,50 100,50 100,50 50,50 0,50"`
+		//	check the result
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("failed filling the curve: expected: %s result: %s", want, output.String())
+		}
+	})
+}
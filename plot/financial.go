@@ -0,0 +1,194 @@
+////////////////////////////////////////////////////////////////////////////////
+//	financial.go  -  Oct-19-2026  -  aldebap
+//
+//	Candlesticks and financebars styles of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+//	width of candlesticks as a fraction of the mean interval between the points
+const (
+	CANDLESTICK_BOX_WIDTH = 0.6
+)
+
+//	default colours of the prices that rise and fall in a period
+var (
+	RISING_COLOUR  = RGB_colour{red: 0, green: 160, blue: 0}
+	FALLING_COLOUR = RED
+)
+
+//	attributes used to describe the colours of candlesticks and financebars (default ones when not informed)
+type Financial_style struct {
+	Rising  *RGB_colour
+	Falling *RGB_colour
+}
+
+//	rising return the colour of the periods where the close price is above the open one
+func (style *Financial_style) rising() RGB_colour {
+	if style.Rising == nil {
+		return RISING_COLOUR
+	}
+
+	return *style.Rising
+}
+
+//	falling return the colour of the periods where the close price is below the open one
+func (style *Financial_style) falling() RGB_colour {
+	if style.Falling == nil {
+		return FALLING_COLOUR
+	}
+
+	return *style.Falling
+}
+
+//	newFinancialPoint create a point from the open, low, high and close prices of a period
+//	(the close is the y, the open is the other limit of the box and the low and high are the whiskers)
+func newFinancialPoint(date, open, low, high, close float64) Point_2d {
+
+	return Point_2d{
+		X:     date,
+		Y:     close,
+		Y_to:  &open,
+		Error: &Error_2d{X_low: date, X_high: date, Y_low: low, Y_high: high},
+	}
+}
+
+//	financialWidth return the width of candlesticks and financebars, based on the mean interval between the points
+func (set *Set_points_2d) financialWidth() float64 {
+
+	if len(set.Point) < 2 {
+		return CANDLESTICK_BOX_WIDTH
+	}
+
+	meanXInterval := (set.Point[len(set.Point)-1].X - set.Point[0].X) / float64(len(set.Point)-1)
+	if meanXInterval < 0 {
+		meanXInterval = -meanXInterval
+	}
+
+	return CANDLESTICK_BOX_WIDTH * meanXInterval
+}
+
+//	generateFinancial generate a candlestick or a financebar for the prices of each point of the set
+func (set *Set_points_2d) generateFinancial(driver GraphicsDriver, x_scale, y_scale *axisScale) {
+
+	halfWidth := set.financialWidth() / 2
+
+	for _, point := range set.Point {
+		if point.Y_to == nil || point.Error == nil {
+			continue
+		}
+		open := *point.Y_to
+
+		colour := set.financial.rising()
+		if point.Y < open {
+			colour = set.financial.falling()
+		}
+
+		scaled_x := int64(x_scale.scale(point.X))
+		scaled_left := int64(x_scale.scale(point.X - halfWidth))
+		scaled_right := int64(x_scale.scale(point.X + halfWidth))
+		scaled_open := int64(clamp(y_scale.scale(open), y_scale))
+		scaled_close := int64(clamp(y_scale.scale(point.Y), y_scale))
+		scaled_low := int64(clamp(y_scale.scale(point.Error.Y_low), y_scale))
+		scaled_high := int64(clamp(y_scale.scale(point.Error.Y_high), y_scale))
+
+		//	a financebar is a vertical line with the open price on the left and the close price on the right
+		if set.Style == FINANCEBARS {
+			driver.Line(scaled_x, scaled_low, scaled_x, scaled_high, colour)
+			driver.Line(scaled_left, scaled_open, scaled_x, scaled_open, colour)
+			driver.Line(scaled_x, scaled_close, scaled_right, scaled_close, colour)
+			continue
+		}
+
+		//	a candlestick is a box between the open and close prices, with whiskers to the low and high prices
+		top, bottom := scaled_open, scaled_close
+		if top < bottom {
+			top, bottom = bottom, top
+		}
+
+		driver.Line(scaled_x, scaled_low, scaled_x, bottom, colour)
+		driver.Line(scaled_x, top, scaled_x, scaled_high, colour)
+
+		if set.Whisker_bars > 0 {
+			whiskerWidth := set.Whisker_bars * halfWidth
+			scaled_whisker_left := int64(x_scale.scale(point.X - whiskerWidth))
+			scaled_whisker_right := int64(x_scale.scale(point.X + whiskerWidth))
+
+			driver.Line(scaled_whisker_left, scaled_low, scaled_whisker_right, scaled_low, colour)
+			driver.Line(scaled_whisker_left, scaled_high, scaled_whisker_right, scaled_high, colour)
+		}
+
+		box := []DriverPoint{
+			{X: scaled_left, Y: bottom},
+			{X: scaled_right, Y: bottom},
+			{X: scaled_right, Y: top},
+			{X: scaled_left, Y: top},
+		}
+
+		if top != bottom {
+			driver.FillPolygon(box, colour, set.Fill)
+		}
+		driver.Polygon(box, colour, false)
+	}
+}
+
+//	parseWhiskerBars parse the whiskerbars option of the candlesticks style: whiskerbars [fraction of the box width]
+func parseWhiskerBars(whiskerBars *float64, options string) (int, error) {
+
+	whiskerBarsRegEx, err := regexp.Compile(`^\s*whiskerbars(\s+([0-9.]+)){0,1}(\s+|$)`)
+	if err != nil {
+		return 0, err
+	}
+
+	match := whiskerBarsRegEx.FindAllStringSubmatch(options, -1)
+	if len(match) != 1 {
+		return 0, nil
+	}
+
+	*whiskerBars = 1
+	if len(match[0][2]) > 0 {
+		*whiskerBars, err = strconv.ParseFloat(match[0][2], 64)
+		if err != nil || *whiskerBars > 1 {
+			return 0, errors.New("invalid whiskerbars width: " + match[0][2])
+		}
+	}
+
+	return len(match[0][0]), nil
+}
+
+//	parseFinancialStyle parse the colours of a set style candlesticks command: [rising [rgb] "colour"] [falling [rgb] "colour"]
+func parseFinancialStyle(financial *Financial_style, options string) error {
+
+	colourOptionRegEx, err := regexp.Compile(`^\s*(rising|falling)\s+(rgb\s+){0,1}"([^"]+)"`)
+	if err != nil {
+		return err
+	}
+
+	for len(options) > 0 {
+		match := colourOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) != 1 {
+			return errors.New("invalid candlesticks style: " + options)
+		}
+
+		colour, err := ParseColour(match[0][3])
+		if err != nil {
+			return err
+		}
+
+		if match[0][1] == "rising" {
+			financial.Rising = &colour
+		} else {
+			financial.Falling = &colour
+		}
+
+		options = options[len(match[0][0]):]
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+////////////////////////////////////////////////////////////////////////////////
+//	financial_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the candlesticks and financebars styles
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestFinancial unit tests for the candlesticks and financebars styles
+func TestFinancial(t *testing.T) {
+
+	t.Run(">>> parseFinancialStyle: rising and falling colours", func(t *testing.T) {
+
+		var got Financial_style
+
+		err := parseFinancialStyle(&got, ` rising rgb "blue" falling "#ff8800"`)
+		if err != nil {
+			t.Errorf("fail parsing candlesticks style: %s", err.Error())
+			return
+		}
+
+		want := []RGB_colour{BLUE, {red: 255, green: 136, blue: 0}}
+		//	check the result
+		if got.rising() != want[0] || got.falling() != want[1] {
+			t.Errorf("failed parsing candlesticks style: expected: %v result: %v %v", want, got.rising(), got.falling())
+		}
+	})
+
+	t.Run(">>> parseWhiskerBars: width of the whisker bars", func(t *testing.T) {
+
+		var got float64
+
+		options := "whiskerbars 0.5 title \"t\""
+		length, err := parseWhiskerBars(&got, options)
+
+		want := 0.5
+		//	check the result
+		if err != nil || want != got || options[length:] != "title \"t\"" {
+			t.Errorf("failed parsing whiskerbars option: expected: %f result: %f %d %v", want, got, length, err)
+		}
+	})
+
+	t.Run(">>> generateFinancial: rising and falling candlesticks", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set := Set_points_2d{
+			Style: CANDLESTICKS,
+			Point: []Point_2d{newFinancialPoint(0, 2, 0, 10, 8), newFinancialPoint(10, 6, 4, 8, 4)},
+		}
+		set.generateFinancial(driver, newAxisScale(&Axis{}, -5, 15, 0, 100), newAxisScale(&Axis{}, 0, 10, 0, 100))
+		writer.Flush()
+
+		want := []string{
+			`<polygon points="10,80 40,80 40,20 10,20" style="fill:#00a000;stroke:none" />`,
+			`<polygon points="60,60 90,60 90,40 60,40" style="fill:#ff0000;stroke:none" />`,
+		}
+		//	check the result
+		for _, polygon := range want {
+			if !strings.Contains(output.String(), polygon) {
+				t.Errorf("failed generating candlesticks: expected: %s result: %s", polygon, output.String())
+			}
+		}
+	})
+}
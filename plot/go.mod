@@ -2,6 +2,11 @@ module github.com/aldebap/go-plot/plot
 
 go 1.17
 
-require github.com/aldebap/go-plot/expression v0.0.0-unpublished
+require (
+	github.com/aldebap/go-plot/expression v0.0.0-unpublished
+	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
+)
+
+require golang.org/x/image v0.1.0 // indirect
 
 replace github.com/aldebap/go-plot/expression v0.0.0-unpublished => ../expression
@@ -6,7 +6,12 @@
 
 package plot
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 //	driver point coordinate
 type DriverPoint struct {
@@ -25,11 +30,62 @@ func (c *RGB_colour) Hexa() string {
 	return fmt.Sprintf("%02x%02x%02x", c.red, c.green, c.blue)
 }
 
+//	colour names accepted when parsing colours
+var (
+	colourName = map[string]RGB_colour{
+		"black":   {red: 0, green: 0, blue: 0},
+		"white":   {red: 255, green: 255, blue: 255},
+		"red":     {red: 255, green: 0, blue: 0},
+		"green":   {red: 0, green: 255, blue: 0},
+		"blue":    {red: 0, green: 0, blue: 255},
+		"gray":    {red: 190, green: 190, blue: 190},
+		"grey":    {red: 190, green: 190, blue: 190},
+		"yellow":  {red: 255, green: 255, blue: 0},
+		"cyan":    {red: 0, green: 255, blue: 255},
+		"magenta": {red: 255, green: 0, blue: 255},
+	}
+)
+
+//	ParseColour parse a colour described as "#rrggbb" or by it's name
+func ParseColour(description string) (RGB_colour, error) {
+
+	if strings.HasPrefix(description, "#") {
+		if len(description) != 7 {
+			return RGB_colour{}, errors.New("invalid colour: " + description)
+		}
+
+		value, err := strconv.ParseUint(description[1:], 16, 32)
+		if err != nil {
+			return RGB_colour{}, errors.New("invalid colour: " + description)
+		}
+
+		return RGB_colour{
+			red:   uint8(value >> 16),
+			green: uint8(value >> 8),
+			blue:  uint8(value),
+		}, nil
+	}
+
+	colour, found := colourName[strings.ToLower(description)]
+	if !found {
+		return RGB_colour{}, errors.New("invalid colour: " + description)
+	}
+
+	return colour, nil
+}
+
+//	rasterEdge return the coordinate of the edge of a cell of a raster stretched along a length
+func rasterEdge(origin, length int64, index, cells int) int64 {
+	return origin + length*int64(index)/int64(cells)
+}
+
+//	graphics driver (the pixels of images are given by rows, from the bottom one)
 type GraphicsDriver interface {
 	GetDimensions() (width, heigth int64)
 	SetDimensions(width int64, height int64) error
 	GetFont() (fontFamily string, fontSize uint8)
 	SetFont(fontFamily string, fontSize uint8) error
+	SetLineStyle(width float64, dashType uint8) error
 
 	Comment(text string)
 	Point(x, y int64, colour RGB_colour) error
@@ -37,6 +93,10 @@ type GraphicsDriver interface {
 	PointToPath(x, y int64) error
 	EndPath() error
 	Line(x1, y1, x2, y2 int64, colour RGB_colour) error
+	Polygon(point []DriverPoint, colour RGB_colour, filled bool) error
+	FillPolygon(point []DriverPoint, colour RGB_colour, fill Fill_style) error
+	Circle(x, y, radius int64, colour RGB_colour, filled bool) error
+	Image(x, y, width, height int64, pixel [][]RGB_colour) error
 	GetTextBox(text string) (width, height int64)
 	Text(x, y, angle int64, text string, colour RGB_colour) error
 	Close() error
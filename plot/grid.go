@@ -0,0 +1,89 @@
+////////////////////////////////////////////////////////////////////////////////
+//	grid.go  -  Oct-19-2026  -  aldebap
+//
+//	Grid lines drawn behind the data of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+//	default colour for grid lines
+var (
+	GRID_COLOUR = RGB_colour{red: 220, green: 220, blue: 220}
+)
+
+//	attributes used to describe the grid lines of a plot (polar step is an angle in the plot's unit)
+type Grid struct {
+	X_tics       bool
+	Y_tics       bool
+	X_minor_tics bool
+	Y_minor_tics bool
+	Polar        bool
+	Polar_step   float64
+	Line_style   Line_style
+}
+
+//	enabled check if any grid line must be drawn
+func (grid *Grid) enabled() bool {
+	return grid.X_tics || grid.Y_tics || grid.X_minor_tics || grid.Y_minor_tics || grid.Polar
+}
+
+//	colour return the colour of the grid lines
+func (grid *Grid) colour() RGB_colour {
+	if grid.Line_style.Colour == nil {
+		return GRID_COLOUR
+	}
+
+	return *grid.Line_style.Colour
+}
+
+//	generatePlotGrid implementation of 2D Go_Plot grid lines generation
+func (p *Plot_2D) generatePlotGrid(driver GraphicsDriver, x_scale, y_scale *axisScale) {
+
+	if !p.Grid.enabled() {
+		return
+	}
+
+	//	get plot dimention from driver's default or from plot parameters when present
+	width, height := driver.GetDimensions()
+
+	if p.Width > 0 {
+		width = p.Width
+	}
+	if p.Height > 0 {
+		height = p.Height
+	}
+
+	colour := p.Grid.colour()
+
+	driver.SetLineStyle(p.Grid.Line_style.lineWidth(), p.Grid.Line_style.dashType())
+	defer driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+
+	//	add a vertical line for each x tick
+	driver.Comment("grid x lines")
+
+	for _, tick := range x_scale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS) {
+		if (tick.minor && !p.Grid.X_minor_tics) || (!tick.minor && !p.Grid.X_tics) {
+			continue
+		}
+		scaled_x := int64(x_scale.scale(tick.value))
+
+		driver.Line(scaled_x, int64(Y_MARGINS), scaled_x, height-int64(Y_MARGINS), colour)
+	}
+
+	//	add an horizontal line for each y tick
+	driver.Comment("grid y lines")
+
+	for _, tick := range y_scale.ticks(MIN_Y_SCALE_DIVISIONS, MAX_Y_SCALE_DIVISIONS) {
+		if (tick.minor && !p.Grid.Y_minor_tics) || (!tick.minor && !p.Grid.Y_tics) {
+			continue
+		}
+		scaled_y := int64(y_scale.scale(tick.value))
+
+		driver.Line(int64(X_MARGINS), scaled_y, width-int64(X_MARGINS), scaled_y, colour)
+	}
+
+	//	add the circles and angle lines of a polar grid
+	if p.Grid.Polar {
+		p.generatePolarGrid(driver, x_scale, y_scale, colour)
+	}
+}
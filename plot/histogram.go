@@ -0,0 +1,138 @@
+////////////////////////////////////////////////////////////////////////////////
+//	histogram.go  -  Oct-19-2026  -  aldebap
+//
+//	Histograms style of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+//	layouts of the sets plotted as histograms
+const (
+	HISTOGRAM_CLUSTERED     uint8 = 0
+	HISTOGRAM_ROWSTACKED    uint8 = 1
+	HISTOGRAM_COLUMNSTACKED uint8 = 2
+	HISTOGRAM_ERRORBARS     uint8 = 3
+)
+
+const (
+	DEFAULT_HISTOGRAM_GAP = 2
+	STACKED_BOX_WIDTH     = 0.5
+)
+
+//	names of the histogram layouts
+var Histogram_layout = map[string]uint8{
+	"clustered":     HISTOGRAM_CLUSTERED,
+	"rowstacked":    HISTOGRAM_ROWSTACKED,
+	"columnstacked": HISTOGRAM_COLUMNSTACKED,
+	"errorbars":     HISTOGRAM_ERRORBARS,
+}
+
+//	attributes used to describe the layout of histograms (the gap, in boxes, separates the clusters)
+type Histogram_style struct {
+	Layout uint8
+	Gap    float64
+}
+
+//	layoutHistograms place the boxes of the sets plotted as histograms, where the x of each point is it's row
+func (p *Plot_2D) layoutHistograms(set_points []Set_points_2d) {
+
+	var histogram []int
+
+	for i := range set_points {
+		if set_points[i].Style == HISTOGRAMS {
+			histogram = append(histogram, i)
+		}
+	}
+	if len(histogram) == 0 {
+		return
+	}
+
+	//	sums of the positive and negative values stacked in each row
+	positive := make(map[float64]float64)
+	negative := make(map[float64]float64)
+
+	for j, index := range histogram {
+		set := &set_points[index]
+		point := make([]Point_2d, len(set.Point))
+
+		for i := range set.Point {
+			point[i] = set.Point[i]
+			bottom := 0.0
+
+			switch p.Histogram.Layout {
+			case HISTOGRAM_ROWSTACKED, HISTOGRAM_COLUMNSTACKED:
+				//	rows are stacked in a box for each set in the column stacked layout
+				stack := point[i].X
+				if p.Histogram.Layout == HISTOGRAM_COLUMNSTACKED {
+					stack = float64(j)
+					point[i].X = stack
+				}
+
+				if point[i].Y >= 0 {
+					bottom = positive[stack]
+					positive[stack] += point[i].Y
+				} else {
+					bottom = negative[stack]
+					negative[stack] += point[i].Y
+				}
+				point[i].Y += bottom
+				point[i].Error = nil
+				set.boxWidth = STACKED_BOX_WIDTH
+
+			default:
+				//	the boxes of a row are placed side by side, centered in the row
+				set.boxWidth = 1 / (float64(len(histogram)) + p.Histogram.Gap)
+				offset := (float64(j) - float64(len(histogram)-1)/2) * set.boxWidth
+
+				point[i].X += offset
+				if point[i].Error != nil && p.Histogram.Layout == HISTOGRAM_ERRORBARS {
+					point_error := *point[i].Error
+
+					point_error.X_low += offset
+					point_error.X_high += offset
+					point[i].Error = &point_error
+				} else {
+					point[i].Error = nil
+				}
+			}
+
+			point[i].Y_to = &bottom
+		}
+
+		set.Point = point
+		set.rowColours = p.Histogram.Layout == HISTOGRAM_COLUMNSTACKED
+	}
+}
+
+//	generateHistogram generate a filled box for each point of a set placed by the histogram layout
+func (set *Set_points_2d) generateHistogram(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	for i, point := range set.Point {
+		bottom := 0.0
+		if point.Y_to != nil {
+			bottom = *point.Y_to
+		}
+
+		//	in the column stacked layout, each row has it's own colour
+		boxColour := colour
+		if set.rowColours {
+			boxColour = plotPallete[i%len(plotPallete)]
+		}
+
+		box := []DriverPoint{
+			{X: int64(x_scale.scale(point.X - set.boxWidth/2)), Y: int64(clamp(y_scale.scale(bottom), y_scale))},
+			{X: int64(x_scale.scale(point.X + set.boxWidth/2)), Y: int64(clamp(y_scale.scale(bottom), y_scale))},
+			{X: int64(x_scale.scale(point.X + set.boxWidth/2)), Y: int64(clamp(y_scale.scale(point.Y), y_scale))},
+			{X: int64(x_scale.scale(point.X - set.boxWidth/2)), Y: int64(clamp(y_scale.scale(point.Y), y_scale))},
+		}
+
+		if box[0].Y == box[2].Y {
+			continue
+		}
+		driver.FillPolygon(box, boxColour, set.Fill)
+		driver.Polygon(box, boxColour, false)
+	}
+
+	//	the error bars are drawn over the boxes
+	set.generateErrorBars(driver, x_scale, y_scale, BLACK)
+}
@@ -0,0 +1,85 @@
+////////////////////////////////////////////////////////////////////////////////
+//	histogram_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the histograms style
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"testing"
+)
+
+//	TestLayoutHistograms unit tests for layoutHistograms()
+func TestLayoutHistograms(t *testing.T) {
+
+	t.Run(">>> layoutHistograms: clustered boxes side by side", func(t *testing.T) {
+
+		plot := Plot_2D{Histogram: Histogram_style{Layout: HISTOGRAM_CLUSTERED, Gap: 2}}
+		set_points := []Set_points_2d{
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: 10}, {X: 1, Y: 20}}},
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: 30}, {X: 1, Y: 40}}},
+		}
+		plot.layoutHistograms(set_points)
+
+		want := []float64{-0.125, 0.875, 0.125, 1.125}
+		got := []float64{set_points[0].Point[0].X, set_points[0].Point[1].X, set_points[1].Point[0].X, set_points[1].Point[1].X}
+		//	check the result
+		for i := range want {
+			if want[i] != got[i] {
+				t.Errorf("failed placing the boxes: expected: %v result: %v", want, got)
+				break
+			}
+		}
+		if set_points[0].boxWidth != 0.25 || *set_points[1].Point[0].Y_to != 0 {
+			t.Errorf("failed placing the boxes: expected width 0.25 from 0 result: %f from %f", set_points[0].boxWidth, *set_points[1].Point[0].Y_to)
+		}
+	})
+
+	t.Run(">>> layoutHistograms: row stacked boxes with positive and negative values", func(t *testing.T) {
+
+		plot := Plot_2D{Histogram: Histogram_style{Layout: HISTOGRAM_ROWSTACKED}}
+		set_points := []Set_points_2d{
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: 10}}},
+			{Style: LINES, Point: []Point_2d{{X: 0, Y: 99}}},
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: -5}}},
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: 20}}},
+		}
+		original := set_points[3].Point
+		plot.layoutHistograms(set_points)
+
+		want := [][2]float64{{0, 10}, {0, -5}, {10, 30}}
+		got := [][2]float64{
+			{*set_points[0].Point[0].Y_to, set_points[0].Point[0].Y},
+			{*set_points[2].Point[0].Y_to, set_points[2].Point[0].Y},
+			{*set_points[3].Point[0].Y_to, set_points[3].Point[0].Y},
+		}
+		//	check the result
+		for i := range want {
+			if want[i] != got[i] {
+				t.Errorf("failed stacking the boxes: expected: %v result: %v", want, got)
+				break
+			}
+		}
+		if set_points[1].Point[0].Y_to != nil || original[0].Y != 20 {
+			t.Errorf("failed stacking the boxes: other styles and the original points must be kept")
+		}
+	})
+
+	t.Run(">>> layoutHistograms: column stacked boxes", func(t *testing.T) {
+
+		plot := Plot_2D{Histogram: Histogram_style{Layout: HISTOGRAM_COLUMNSTACKED}}
+		set_points := []Set_points_2d{
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: 10}, {X: 1, Y: 20}}},
+			{Style: HISTOGRAMS, Point: []Point_2d{{X: 0, Y: 30}, {X: 1, Y: 40}}},
+		}
+		plot.layoutHistograms(set_points)
+
+		want := [][3]float64{{1, 30, 70}}
+		got := [][3]float64{{set_points[1].Point[1].X, *set_points[1].Point[1].Y_to, set_points[1].Point[1].Y}}
+		//	check the result
+		if want[0] != got[0] || !set_points[1].rowColours {
+			t.Errorf("failed stacking the columns: expected: %v result: %v", want, got)
+		}
+	})
+}
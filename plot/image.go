@@ -0,0 +1,127 @@
+////////////////////////////////////////////////////////////////////////////////
+//	image.go  -  Oct-19-2026  -  aldebap
+//
+//	Image style of a 2D Go-Plot, used to draw heat maps
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+//	paletteColours check if the colours of the points of the set are mapped into the palette
+func (set *Set_points_2d) paletteColours() bool {
+	return set.Style == IMAGE || set.Line_style.Colour_source == COLOUR_PALETTE
+}
+
+//	imageGrid return the distinct x and y values of the points of the set, in ascending order
+func (set *Set_points_2d) imageGrid() ([]float64, []float64) {
+
+	var column, row []float64
+	found_x := make(map[float64]bool)
+	found_y := make(map[float64]bool)
+
+	for _, point := range set.Point {
+		if !found_x[point.X] {
+			found_x[point.X] = true
+			column = append(column, point.X)
+		}
+		if !found_y[point.Y] {
+			found_y[point.Y] = true
+			row = append(row, point.Y)
+		}
+	}
+	sort.Float64s(column)
+	sort.Float64s(row)
+
+	return column, row
+}
+
+//	cellSize return the distance between the values of a uniform grid (1 for a single value)
+func cellSize(value []float64) float64 {
+	if len(value) < 2 {
+		return 1
+	}
+
+	return (value[len(value)-1] - value[0]) / float64(len(value)-1)
+}
+
+//	imageBounds return the limits of the image, where each point is the centre of a cell of the grid
+func (set *Set_points_2d) imageBounds() (left, right, bottom, top float64) {
+
+	column, row := set.imageGrid()
+	half_x := cellSize(column) / 2
+	half_y := cellSize(row) / 2
+
+	return column[0] - half_x, column[len(column)-1] + half_x, row[0] - half_y, row[len(row)-1] + half_y
+}
+
+//	generateImage generate a raster with the colour of the palette for the value of each point of a uniform grid,
+//	limited to the cells whose centre is in the range of the axes
+func (set *Set_points_2d) generateImage(driver GraphicsDriver, x_scale, y_scale *axisScale) {
+
+	if set.colours == nil || len(set.Point) == 0 {
+		return
+	}
+
+	column, row := set.imageGrid()
+	if len(column)*len(row) != len(set.Point) {
+		fmt.Fprintf(os.Stderr, "[warning] image requires a single value for each point of a grid: %s\n", set.Title)
+		return
+	}
+
+	visible := func(value []float64, scale *axisScale) []float64 {
+		var inside []float64
+
+		for _, v := range value {
+			if scale.contains(v) {
+				inside = append(inside, v)
+			}
+		}
+		return inside
+	}
+	visibleColumn := visible(column, x_scale)
+	visibleRow := visible(row, y_scale)
+
+	if len(visibleColumn) == 0 || len(visibleRow) == 0 {
+		return
+	}
+
+	columnIndex := make(map[float64]int)
+	for i, x := range visibleColumn {
+		columnIndex[x] = i
+	}
+	rowIndex := make(map[float64]int)
+	for j, y := range visibleRow {
+		rowIndex[y] = j
+	}
+
+	pixel := make([][]RGB_colour, len(visibleRow))
+	for j := range pixel {
+		pixel[j] = make([]RGB_colour, len(visibleColumn))
+	}
+
+	for _, point := range set.Point {
+		i, found_x := columnIndex[point.X]
+		j, found_y := rowIndex[point.Y]
+		if !found_x || !found_y || point.Colour == nil {
+			continue
+		}
+
+		pixel[j][i] = set.colours.colour(*point.Colour)
+	}
+
+	//	the cells in the border of the image are limited by the plot border
+	half_x := cellSize(column) / 2
+	half_y := cellSize(row) / 2
+
+	x1 := int64(clamp(x_scale.scale(visibleColumn[0]-half_x), x_scale))
+	x2 := int64(clamp(x_scale.scale(visibleColumn[len(visibleColumn)-1]+half_x), x_scale))
+	y1 := int64(clamp(y_scale.scale(visibleRow[0]-half_y), y_scale))
+	y2 := int64(clamp(y_scale.scale(visibleRow[len(visibleRow)-1]+half_y), y_scale))
+
+	driver.Image(x1, y1, x2-x1, y2-y1, pixel)
+}
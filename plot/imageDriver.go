@@ -16,6 +16,8 @@ import (
 	"image/jpeg"
 	"image/png"
 	"io/ioutil"
+	"math"
+	"sort"
 
 	"github.com/golang/freetype"
 	"github.com/golang/freetype/truetype"
@@ -26,9 +28,14 @@ type Image_Driver struct {
 	fileFormat string
 	width      int64
 	height     int64
+	background RGB_colour
+	quality    int
 	image      *image.RGBA
 	path       []DriverPoint
 	pathColour RGB_colour
+	lineWidth  float64
+	dashType   uint8
+	dashOffset float64
 	fontFamily string
 	fontSize   uint8
 	dpi        uint16
@@ -37,64 +44,46 @@ type Image_Driver struct {
 }
 
 //	create a new PNG_Driver
-func NewPNG_Driver(writer *bufio.Writer) GraphicsDriver {
-	const (
-		WIDTH       = 640
-		HEIGHT      = 480
-		FONT_FAMILY = "Verdana"
-		FONT_SIZE   = 10
-		DPI         = 72
-	)
-
-	return &Image_Driver{
-		writer:     writer,
-		fileFormat: "png",
-		width:      WIDTH,
-		height:     HEIGHT,
-		fontFamily: FONT_FAMILY,
-		fontSize:   FONT_SIZE,
-		dpi:        DPI,
-	}
+func NewPNG_Driver(writer *bufio.Writer, options *TerminalOptions) GraphicsDriver {
+	return newImage_Driver(writer, "png", options)
 }
 
 //	create a new GIF
-func NewGIF_Driver(writer *bufio.Writer) GraphicsDriver {
-	const (
-		WIDTH       = 640
-		HEIGHT      = 480
-		FONT_FAMILY = "Verdana"
-		FONT_SIZE   = 10
-		DPI         = 72
-	)
-
-	return &Image_Driver{
-		writer:     writer,
-		fileFormat: "gif",
-		width:      WIDTH,
-		height:     HEIGHT,
-		fontFamily: FONT_FAMILY,
-		fontSize:   FONT_SIZE,
-		dpi:        DPI,
-	}
+func NewGIF_Driver(writer *bufio.Writer, options *TerminalOptions) GraphicsDriver {
+	return newImage_Driver(writer, "gif", options)
 }
 
 //	create a new JPEG
-func NewJPEG_Driver(writer *bufio.Writer) GraphicsDriver {
+func NewJPEG_Driver(writer *bufio.Writer, options *TerminalOptions) GraphicsDriver {
+	return newImage_Driver(writer, "jpeg", options)
+}
+
+//	newImage_Driver create a new Image_Driver for a given file format
+func newImage_Driver(writer *bufio.Writer, fileFormat string, options *TerminalOptions) *Image_Driver {
 	const (
-		WIDTH       = 640
-		HEIGHT      = 480
-		FONT_FAMILY = "Verdana"
-		FONT_SIZE   = 10
-		DPI         = 72
+		WIDTH  = 640
+		HEIGHT = 480
+		DPI    = 72
 	)
 
+	width, height := options.dimensions(WIDTH, HEIGHT)
+	quality := DEFAULT_JPEG_QUALITY
+
+	if options != nil && options.Quality > 0 {
+		quality = options.Quality
+	}
+
 	return &Image_Driver{
 		writer:     writer,
-		fileFormat: "jpeg",
-		width:      WIDTH,
-		height:     HEIGHT,
-		fontFamily: FONT_FAMILY,
-		fontSize:   FONT_SIZE,
+		fileFormat: fileFormat,
+		width:      width,
+		height:     height,
+		background: options.background(),
+		quality:    quality,
+		lineWidth:  DEFAULT_LINE_WIDTH,
+		dashType:   DASH_SOLID,
+		fontFamily: options.fontFamily(),
+		fontSize:   options.fontSize(),
 		dpi:        DPI,
 	}
 }
@@ -111,8 +100,9 @@ func (driver *Image_Driver) SetDimensions(width int64, height int64) error {
 
 	driver.image = image.NewRGBA(image.Rectangle{image.Point{0, 0}, image.Point{int(width), int(height)}})
 
-	//	set background as white
-	draw.Draw(driver.image, driver.image.Bounds(), image.White, image.ZP, draw.Src)
+	//	set the background colour
+	backgroundColour := color.RGBA{driver.background.red, driver.background.green, driver.background.blue, 255}
+	draw.Draw(driver.image, driver.image.Bounds(), image.NewUniform(backgroundColour), image.ZP, draw.Src)
 
 	return nil
 }
@@ -155,6 +145,17 @@ func (driver *Image_Driver) SetFont(fontFamily string, fontSize uint8) error {
 	return nil
 }
 
+//	SetLineStyle set the width and dash type used to draw lines and paths
+func (driver *Image_Driver) SetLineStyle(width float64, dashType uint8) error {
+	if width <= 0 {
+		return errors.New("line width expected to be positive")
+	}
+	driver.lineWidth = width
+	driver.dashType = dashType
+
+	return nil
+}
+
 //	Comment write a comment int the the Image graphic
 func (driver *Image_Driver) Comment(text string) {
 	//	cannot add comments to Image
@@ -167,7 +168,7 @@ func (driver *Image_Driver) Point(x, y int64, colour RGB_colour) error {
 	}
 
 	pointColour := color.RGBA{colour.red, colour.green, colour.blue, 255}
-	driver.image.Set(int(x), int(y), pointColour)
+	driver.image.Set(int(x), int(driver.height-y), pointColour)
 
 	return nil
 }
@@ -200,21 +201,18 @@ func (driver *Image_Driver) EndPath() error {
 		return errors.New("not enough points to draw a path")
 	}
 
-	var x1, y1 int64
+	if driver.image == nil {
+		return errors.New("cannot draw a path to a non initialized graphics driver")
+	}
 
-	for i, point := range driver.path {
-		if i == 0 {
-			x1 = point.X
-			y1 = driver.height - point.Y
-		} else {
-			err := driver.Line(x1, y1, point.X, driver.height-point.Y, driver.pathColour)
-			if err != nil {
-				return err
-			}
+	lineColour := color.RGBA{driver.pathColour.red, driver.pathColour.green, driver.pathColour.blue, 255}
 
-			x1 = point.X
-			y1 = driver.height - point.Y
-		}
+	//	the dash pattern continues from one segment to the next one
+	driver.dashOffset = 0
+
+	for i := 1; i < len(driver.path); i++ {
+		driver.drawLine(float64(driver.path[i-1].X), float64(driver.height-driver.path[i-1].Y),
+			float64(driver.path[i].X), float64(driver.height-driver.path[i].Y), lineColour)
 	}
 
 	driver.path = nil
@@ -230,52 +228,256 @@ func (driver *Image_Driver) Line(x1, y1, x2, y2 int64, colour RGB_colour) error
 
 	lineColour := color.RGBA{colour.red, colour.green, colour.blue, 255}
 
-	//	for better results, change a function variable if angle is bigger the 45 deg
-	if y2-y1 > x2-x1 {
-		//	swap the points if y2 < y1
-		if y2 < y1 {
-			aux := x1
-			x1 = x2
-			x2 = aux
-
-			aux = y1
-			y1 = y2
-			y2 = aux
+	driver.dashOffset = 0
+	driver.drawLine(float64(x1), float64(driver.height-y1), float64(x2), float64(driver.height-y2), lineColour)
+
+	return nil
+}
+
+//	drawLine draws a line between two points in image coordinates using the current line style
+func (driver *Image_Driver) drawLine(x1, y1, x2, y2 float64, lineColour color.RGBA) {
+
+	pattern := dashPattern(driver.dashType, driver.lineWidth)
+	length := math.Hypot(x2-x1, y2-y1)
+
+	//	one step for each pixel in the longest direction
+	steps := int(math.Ceil(math.Max(math.Abs(x2-x1), math.Abs(y2-y1))))
+	if steps == 0 {
+		steps = 1
+	}
+
+	for i := 0; i <= steps; i++ {
+		fraction := float64(i) / float64(steps)
+
+		if len(pattern) > 0 && !dashVisible(driver.dashOffset+fraction*length, pattern) {
+			continue
 		}
+		driver.brush(x1+fraction*(x2-x1), y1+fraction*(y2-y1), lineColour)
+	}
+
+	driver.dashOffset += length
+}
+
+//	dashVisible check if a position along a line is in a dash or in a gap of the pattern
+func dashVisible(position float64, pattern []float64) bool {
+
+	var total float64
 
-		//	use a line math function to draw the line | x: f(y)
-		delta := float64(x2-x1) / float64(y2-y1)
-		x := float64(x1)
+	for _, length := range pattern {
+		total += length
+	}
+	position = math.Mod(position, total)
 
-		for y := y1; y <= y2; y++ {
-			driver.image.Set(int(x), int(y), lineColour)
-			x += delta
+	for i, length := range pattern {
+		if position < length {
+			return i%2 == 0
 		}
-	} else {
-		//	swap the points if x2 < x1
-		if x2 < x1 {
-			aux := x1
-			x1 = x2
-			x2 = aux
-
-			aux = y1
-			y1 = y2
-			y2 = aux
+		position -= length
+	}
+
+	return true
+}
+
+//	brush draws a circle with the diameter of the line width
+func (driver *Image_Driver) brush(x, y float64, colour color.RGBA) {
+
+	if driver.lineWidth <= 1 {
+		driver.image.Set(int(math.Round(x)), int(math.Round(y)), colour)
+		return
+	}
+
+	radius := driver.lineWidth / 2
+
+	for i := math.Floor(x - radius); i <= math.Ceil(x+radius); i++ {
+		for j := math.Floor(y - radius); j <= math.Ceil(y+radius); j++ {
+			if (i-x)*(i-x)+(j-y)*(j-y) <= radius*radius {
+				driver.image.Set(int(i), int(j), colour)
+			}
 		}
+	}
+}
+
+//	Polygon draws an open or filled polygon in the Image graphic
+func (driver *Image_Driver) Polygon(point []DriverPoint, colour RGB_colour, filled bool) error {
+	if driver.image == nil {
+		return errors.New("cannot draw a polygon to a non initialized graphics driver")
+	}
+	if len(point) < 3 {
+		return errors.New("not enough points to draw a polygon")
+	}
+
+	polygonColour := color.RGBA{colour.red, colour.green, colour.blue, 255}
+
+	//	convert the vertices to image coordinates
+	vertex := make([][2]float64, len(point))
+	for i := range point {
+		vertex[i] = [2]float64{float64(point[i].X), float64(driver.height - point[i].Y)}
+	}
+
+	if filled {
+		driver.fillPolygon(vertex, polygonColour)
+		return nil
+	}
+
+	driver.dashOffset = 0
+	for i := range vertex {
+		next := vertex[(i+1)%len(vertex)]
+
+		driver.drawLine(vertex[i][0], vertex[i][1], next[0], next[1], polygonColour)
+	}
+
+	return nil
+}
+
+//	FillPolygon draws the interior of a polygon with a fill style in the Image graphic
+func (driver *Image_Driver) FillPolygon(point []DriverPoint, colour RGB_colour, fill Fill_style) error {
+	if driver.image == nil {
+		return errors.New("cannot draw a polygon to a non initialized graphics driver")
+	}
+	if len(point) < 3 {
+		return errors.New("not enough points to draw a polygon")
+	}
+
+	//	convert the vertices to image coordinates
+	vertex := make([][2]float64, len(point))
+	for i := range point {
+		vertex[i] = [2]float64{float64(point[i].X), float64(driver.height - point[i].Y)}
+	}
 
-		//	use a line math function to draw the line | y: f(x)
-		delta := float64(y2-y1) / float64(x2-x1)
-		y := float64(y1)
+	switch fill.effectiveType() {
+	case FILL_EMPTY:
 
-		for x := x1; x <= x2; x++ {
-			driver.image.Set(int(x), int(y), lineColour)
-			y += delta
+	case FILL_PATTERN:
+		//	the pixels out of the pattern's lines are painted with the background when it's not transparent
+		lines := fill.lines()
+		lineColour := color.RGBA{colour.red, colour.green, colour.blue, 255}
+		backgroundColour := color.RGBA{driver.background.red, driver.background.green, driver.background.blue, 255}
+
+		scanPolygon(vertex, func(x, y int) {
+			if lines.contains(int64(x), int64(y)) {
+				driver.image.Set(x, y, lineColour)
+			} else if !fill.Transparent {
+				driver.image.Set(x, y, backgroundColour)
+			}
+		})
+
+	default:
+		solidColour := fill.solidColour(colour, driver.background)
+		opacity := fill.opacity()
+
+		scanPolygon(vertex, func(x, y int) {
+			previous := driver.image.RGBAAt(x, y)
+			mix := func(c, p uint8) uint8 {
+				return uint8(opacity*float64(c) + (1-opacity)*float64(p) + 0.5)
+			}
+
+			driver.image.SetRGBA(x, y, color.RGBA{mix(solidColour.red, previous.R), mix(solidColour.green, previous.G), mix(solidColour.blue, previous.B), 255})
+		})
+	}
+
+	return nil
+}
+
+//	Circle draws an open or filled circle in the Image graphic
+func (driver *Image_Driver) Circle(x, y, radius int64, colour RGB_colour, filled bool) error {
+	if driver.image == nil {
+		return errors.New("cannot draw a circle to a non initialized graphics driver")
+	}
+
+	circleColour := color.RGBA{colour.red, colour.green, colour.blue, 255}
+	centre_x := float64(x)
+	centre_y := float64(driver.height - y)
+	r := float64(radius)
+
+	if filled {
+		for i := centre_x - r; i <= centre_x+r; i++ {
+			for j := centre_y - r; j <= centre_y+r; j++ {
+				if (i-centre_x)*(i-centre_x)+(j-centre_y)*(j-centre_y) <= r*r {
+					driver.image.Set(int(i), int(j), circleColour)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	//	the outline is approximated by segments of a few pixels
+	segments := int(math.Max(16, 2*math.Pi*r/2))
+
+	driver.dashOffset = 0
+	for i := 0; i < segments; i++ {
+		angle1 := 2 * math.Pi * float64(i) / float64(segments)
+		angle2 := 2 * math.Pi * float64(i+1) / float64(segments)
+
+		driver.drawLine(centre_x+r*math.Cos(angle1), centre_y+r*math.Sin(angle1),
+			centre_x+r*math.Cos(angle2), centre_y+r*math.Sin(angle2), circleColour)
+	}
+
+	return nil
+}
+
+//	Image draws a raster of colours stretched into a rectangle, writing the pixels directly in the Image graphic
+func (driver *Image_Driver) Image(x, y, width, height int64, pixel [][]RGB_colour) error {
+	if driver.image == nil {
+		return errors.New("cannot draw an image to a non initialized graphics driver")
+	}
+	if len(pixel) == 0 || width <= 0 || height <= 0 {
+		return errors.New("not enough pixels to draw an image")
+	}
+
+	//	each pixel of the graphic gets the colour of the cell of the raster it's in
+	for j := int64(0); j < height; j++ {
+		row := pixel[int(j*int64(len(pixel))/height)]
+		image_y := int(driver.height - y - j - 1)
+
+		for i := int64(0); i < width && len(row) > 0; i++ {
+			colour := row[int(i*int64(len(row))/width)]
+
+			driver.image.SetRGBA(int(x+i), image_y, color.RGBA{colour.red, colour.green, colour.blue, 255})
 		}
 	}
 
 	return nil
 }
 
+//	fillPolygon fill a polygon in image coordinates using the even-odd rule
+func (driver *Image_Driver) fillPolygon(vertex [][2]float64, colour color.RGBA) {
+	scanPolygon(vertex, func(x, y int) {
+		driver.image.Set(x, y, colour)
+	})
+}
+
+//	scanPolygon call the paint function for each pixel inside a polygon in image coordinates, using the even-odd rule
+func scanPolygon(vertex [][2]float64, paint func(x, y int)) {
+
+	min_y, max_y := vertex[0][1], vertex[0][1]
+	for _, point := range vertex {
+		min_y = math.Min(min_y, point[1])
+		max_y = math.Max(max_y, point[1])
+	}
+
+	//	for each row of pixels, fill between pairs of edge intersections
+	for y := math.Floor(min_y); y <= math.Ceil(max_y); y++ {
+		var intersection []float64
+
+		for i := range vertex {
+			p1 := vertex[i]
+			p2 := vertex[(i+1)%len(vertex)]
+
+			if (p1[1] <= y && p2[1] > y) || (p2[1] <= y && p1[1] > y) {
+				intersection = append(intersection, p1[0]+(y-p1[1])*(p2[0]-p1[0])/(p2[1]-p1[1]))
+			}
+		}
+		sort.Float64s(intersection)
+
+		for i := 0; i+1 < len(intersection); i += 2 {
+			for x := math.Ceil(intersection[i]); x <= math.Floor(intersection[i+1]); x++ {
+				paint(int(x), int(y))
+			}
+		}
+	}
+}
+
 //	GetTextBox evaluate the width and height of the rectangle required to draw the text string using a given font size
 func (driver *Image_Driver) GetTextBox(text string) (width, height int64) {
 	if driver.font == nil {
@@ -302,26 +504,91 @@ func (driver *Image_Driver) GetTextBox(text string) (width, height int64) {
 
 }
 
-//	Text writes a string to the specified point in the Image graphic
+//	Text writes a string to the specified point in the Image graphic, rotated by an angle in degrees around it
 func (driver *Image_Driver) Text(x, y, angle int64, text string, colour RGB_colour) error {
 	if driver.ctx == nil {
 		return errors.New("cannot draw text to a non initialized font options")
 	}
 
-	var pt = freetype.Pt(int(x), int(driver.height-y)+int(driver.ctx.PointToFixed(float64(driver.fontSize))>>6))
+	var ascent = int(driver.ctx.PointToFixed(float64(driver.fontSize)) >> 6)
 	var textColour = color.RGBA{colour.red, colour.green, colour.blue, 255}
 	var err error
 
-	driver.ctx.SetSrc(image.NewUniform(textColour))
+	if angle == 0 {
+		driver.ctx.SetSrc(image.NewUniform(textColour))
 
-	_, err = driver.ctx.DrawString(text, pt)
+		_, err = driver.ctx.DrawString(text, freetype.Pt(int(x), int(driver.height-y)+ascent))
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	//	rotated text is drawn in a mask that is copied to the graphic pixel by pixel
+	textWidth, _ := driver.GetTextBox(text)
+	mask := image.NewAlpha(image.Rect(0, 0, int(textWidth)+ascent/2, ascent*3/2))
+
+	driver.ctx.SetClip(mask.Bounds())
+	driver.ctx.SetDst(mask)
+	driver.ctx.SetSrc(image.Opaque)
+
+	_, err = driver.ctx.DrawString(text, freetype.Pt(0, ascent))
+
+	driver.ctx.SetClip(driver.image.Bounds())
+	driver.ctx.SetDst(driver.image)
 	if err != nil {
 		return err
 	}
 
+	//	like in SVG, the text is rotated around the start of its baseline
+	driver.rotateMask(mask, image.Point{0, ascent}, float64(x), float64(driver.height-y), float64(angle)*math.Pi/180, textColour)
+
 	return nil
 }
 
+//	rotateMask paint a colour through a mask, placing the mask's origin in a point in image coordinates and rotating it around that point
+func (driver *Image_Driver) rotateMask(mask *image.Alpha, origin image.Point, x, y, angle float64, colour color.RGBA) {
+
+	cos, sin := math.Cos(angle), math.Sin(angle)
+	bounds := mask.Bounds().Sub(origin)
+
+	//	the rectangle of the graphic that contains the rotated mask
+	min_x, min_y, max_x, max_y := x, y, x, y
+	for _, corner := range [][2]float64{{float64(bounds.Min.X), float64(bounds.Min.Y)}, {float64(bounds.Max.X), float64(bounds.Min.Y)},
+		{float64(bounds.Min.X), float64(bounds.Max.Y)}, {float64(bounds.Max.X), float64(bounds.Max.Y)}} {
+		corner_x := x + corner[0]*cos - corner[1]*sin
+		corner_y := y + corner[0]*sin + corner[1]*cos
+
+		min_x, max_x = math.Min(min_x, corner_x), math.Max(max_x, corner_x)
+		min_y, max_y = math.Min(min_y, corner_y), math.Max(max_y, corner_y)
+	}
+
+	//	each pixel of the graphic takes the opacity of the mask pixel rotated back to it
+	for j := math.Floor(min_y); j <= math.Ceil(max_y); j++ {
+		for i := math.Floor(min_x); i <= math.Ceil(max_x); i++ {
+			mask_x := int(math.Floor((i-x)*cos + (j-y)*sin))
+			mask_y := int(math.Floor(-(i-x)*sin + (j-y)*cos))
+
+			if !(image.Point{mask_x, mask_y}).In(bounds) || !(image.Point{int(i), int(j)}).In(driver.image.Bounds()) {
+				continue
+			}
+
+			opacity := float64(mask.AlphaAt(mask_x+origin.X, mask_y+origin.Y).A) / 255
+			if opacity == 0 {
+				continue
+			}
+
+			previous := driver.image.RGBAAt(int(i), int(j))
+			mix := func(c, p uint8) uint8 {
+				return uint8(opacity*float64(c) + (1-opacity)*float64(p) + 0.5)
+			}
+
+			driver.image.SetRGBA(int(i), int(j), color.RGBA{mix(colour.R, previous.R), mix(colour.G, previous.G), mix(colour.B, previous.B), 255})
+		}
+	}
+}
+
 //	Close finalize the Image graphic
 func (driver *Image_Driver) Close() error {
 	switch driver.fileFormat {
@@ -338,7 +605,7 @@ func (driver *Image_Driver) Close() error {
 		}
 
 	case "jpeg":
-		err := jpeg.Encode(driver.writer, driver.image, nil)
+		err := jpeg.Encode(driver.writer, driver.image, &jpeg.Options{Quality: driver.quality})
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,107 @@
+////////////////////////////////////////////////////////////////////////////////
+//	image_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the image style
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	imageSet create a set with an image of a grid of values
+func imageSet(value [][]float64) Set_points_2d {
+
+	set := Set_points_2d{Style: IMAGE}
+
+	for j := range value {
+		for i := range value[j] {
+			set.Point = append(set.Point, Point_2d{X: float64(i), Y: float64(j), Colour: &value[j][i]})
+		}
+	}
+
+	return set
+}
+
+//	TestImage unit tests for the image style
+func TestImage(t *testing.T) {
+
+	t.Run(">>> imageBounds: half a cell around the points", func(t *testing.T) {
+
+		set := imageSet([][]float64{{1, 2, 3}, {4, 5, 6}})
+
+		left, right, bottom, top := set.imageBounds()
+		//	check the result
+		if left != -0.5 || right != 2.5 || bottom != -0.5 || top != 1.5 {
+			t.Errorf("failed evaluating image bounds: expected: -0.5 2.5 -0.5 1.5 result: %f %f %f %f", left, right, bottom, top)
+		}
+	})
+
+	t.Run(">>> generateImage: grid of rectangles", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set := imageSet([][]float64{{0, 1}, {1, 0}})
+		set.colours = &colourMap{
+			palette: &Palette{Defined: []Palette_colour{{Value: 0, Colour: BLACK}, {Value: 1, Colour: WHITE}}},
+			scale:   newAxisScale(&Axis{}, 0, 1, 0, 1),
+		}
+		set.generateImage(driver, newAxisScale(&Axis{}, -0.5, 1.5, 0, 100), newAxisScale(&Axis{}, -0.5, 1.5, 0, 100))
+		writer.Flush()
+
+		want := `<rect x="50" y="0" width="50" height="50" style="fill:#000000;stroke:none" />`
+		//	check the result
+		if strings.Count(output.String(), "<rect x=") != 4 || !strings.Contains(output.String(), want) {
+			t.Errorf("failed generating image: expected: %s result: %s", want, output.String())
+		}
+	})
+
+	t.Run(">>> generateImage: incomplete grid", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set := imageSet([][]float64{{0, 1}, {1, 0}})
+		set.Point = set.Point[:3]
+		set.colours = &colourMap{palette: &Palette{}, scale: newAxisScale(&Axis{}, 0, 1, 0, 1)}
+		set.generateImage(driver, newAxisScale(&Axis{}, -0.5, 1.5, 0, 100), newAxisScale(&Axis{}, -0.5, 1.5, 0, 100))
+		writer.Flush()
+
+		//	check the result
+		if strings.Contains(output.String(), "<rect x=") {
+			t.Errorf("failed generating image: expected no rectangles result: %s", output.String())
+		}
+	})
+
+	t.Run(">>> Image_Driver.Image: pixels written in the raster", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		driver := newImage_Driver(bufio.NewWriter(&output), "png", &TerminalOptions{Width: 10, Height: 10})
+		driver.SetDimensions(10, 10)
+
+		err := driver.Image(0, 0, 10, 10, [][]RGB_colour{{RED, GREEN}, {BLUE, WHITE}})
+		if err != nil {
+			t.Errorf("fail drawing image: %s", err.Error())
+			return
+		}
+
+		//	the first row of the raster is at the bottom of the graphic
+		bottomLeft := driver.image.RGBAAt(0, 9)
+		topRight := driver.image.RGBAAt(9, 0)
+		if bottomLeft.R != 255 || bottomLeft.G != 0 || topRight.R != 255 || topRight.B != 255 {
+			t.Errorf("failed drawing image: expected red at the bottom left and white at the top right result: %v %v", bottomLeft, topRight)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+////////////////////////////////////////////////////////////////////////////////
+//	implicit.go  -  Oct-19-2026  -  aldebap
+//
+//	Implicit plots of the curves of relations f(x,y) = g(x,y)
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/aldebap/go-plot/expression"
+)
+
+//	the relation is screened in a coarse grid and the cells where it's curves pass are refined
+const (
+	IMPLICIT_GRID       = 32
+	IMPLICIT_REFINEMENT = 8
+)
+
+//	implicitFunction return the function of x and y whose zero set are the curves of a relation f(x,y) = g(x,y)
+func implicitFunction(relation string) (string, error) {
+
+	side := strings.Split(relation, "=")
+	if len(side) != 2 || len(strings.TrimSpace(side[0])) == 0 || len(strings.TrimSpace(side[1])) == 0 {
+		return "", errors.New("implicit relation expected to be f(x,y) = g(x,y): " + relation)
+	}
+
+	return "(" + strings.TrimSpace(side[0]) + ")-(" + strings.TrimSpace(side[1]) + ")", nil
+}
+
+//	ImplicitPaths trace the curves of a relation f(x,y) = g(x,y) from Min_x, Min_y to Max_x, Max_y: the relation is
+//	sampled in an adaptive grid, where the coarse cells crossed by the curves are refined, and the curves are traced
+//	by marching squares in the refined cells
+func ImplicitPaths(relation string, angles uint8, min_x, max_x, min_y, max_y float64) ([]Contour_path, error) {
+
+	if min_x >= max_x || min_y >= max_y {
+		return nil, errors.New("invalid range of samples: " + relation)
+	}
+
+	function, err := implicitFunction(relation)
+	if err != nil {
+		return nil, err
+	}
+
+	functionExpr, err := expression.NewExpression(function)
+	if err != nil {
+		return nil, errors.New("error parsing function to be plotted: " + err.Error())
+	}
+
+	//	create the symbol table
+	symbolTable := expression.NewFloatSymbolTable()
+
+	expression.AddStandardMathFuncs(symbolTable)
+	expression.SetAngleUnit(symbolTable, angles)
+
+	//	the points of the refined grid are evaluated only when needed (the other ones are left out of the curves)
+	size := IMPLICIT_GRID*IMPLICIT_REFINEMENT + 1
+
+	column := make([]float64, size)
+	row := make([]float64, size)
+	value := make([][]float64, size)
+	evaluated := make([][]bool, size)
+
+	for k := 0; k < size; k++ {
+		column[k] = min_x + (max_x-min_x)*float64(k)/float64(size-1)
+		row[k] = min_y + (max_y-min_y)*float64(k)/float64(size-1)
+		value[k] = make([]float64, size)
+		evaluated[k] = make([]bool, size)
+		for i := range value[k] {
+			value[k][i] = math.NaN()
+		}
+	}
+
+	sample := func(i, j int) (float64, error) {
+		if !evaluated[j][i] {
+			symbolTable.SetValue("x", column[i])
+			symbolTable.SetValue("y", row[j])
+
+			result, err := functionExpr.Evaluate(symbolTable)
+			if err != nil {
+				return 0, errors.New("error evaluating function to be plotted: " + err.Error())
+			}
+			if !math.IsInf(result, 0) {
+				value[j][i] = result
+			}
+			evaluated[j][i] = true
+		}
+
+		return value[j][i], nil
+	}
+
+	//	a coarse cell is refined when the signs of it's corners and centre are not the same
+	refined := make([][]bool, IMPLICIT_GRID)
+	var queue [][2]int
+
+	for cj := 0; cj < IMPLICIT_GRID; cj++ {
+		refined[cj] = make([]bool, IMPLICIT_GRID)
+
+		for ci := 0; ci < IMPLICIT_GRID; ci++ {
+			i, j := ci*IMPLICIT_REFINEMENT, cj*IMPLICIT_REFINEMENT
+			half := IMPLICIT_REFINEMENT / 2
+
+			var above, below bool
+
+			for _, corner := range [][2]int{{i, j}, {i + IMPLICIT_REFINEMENT, j}, {i, j + IMPLICIT_REFINEMENT}, {i + IMPLICIT_REFINEMENT, j + IMPLICIT_REFINEMENT}, {i + half, j + half}} {
+				result, err := sample(corner[0], corner[1])
+				if err != nil {
+					return nil, err
+				}
+				if math.IsNaN(result) {
+					continue
+				}
+				above = above || result >= 0
+				below = below || result < 0
+			}
+
+			if above && below {
+				refined[cj][ci] = true
+				queue = append(queue, [2]int{ci, cj})
+			}
+		}
+	}
+
+	//	the refined cells are sampled in the fine grid and the curves leaving them refine their neighbours
+	for len(queue) > 0 {
+		ci, cj := queue[0][0], queue[0][1]
+		queue = queue[1:]
+
+		i0, j0 := ci*IMPLICIT_REFINEMENT, cj*IMPLICIT_REFINEMENT
+
+		for j := j0; j <= j0+IMPLICIT_REFINEMENT; j++ {
+			for i := i0; i <= i0+IMPLICIT_REFINEMENT; i++ {
+				_, err := sample(i, j)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for _, neighbour := range []struct {
+			ci, cj int
+			i, j   int
+			di, dj int
+		}{
+			{ci - 1, cj, i0, j0, 0, 1},
+			{ci + 1, cj, i0 + IMPLICIT_REFINEMENT, j0, 0, 1},
+			{ci, cj - 1, i0, j0, 1, 0},
+			{ci, cj + 1, i0, j0 + IMPLICIT_REFINEMENT, 1, 0},
+		} {
+			if neighbour.ci < 0 || neighbour.ci >= IMPLICIT_GRID || neighbour.cj < 0 || neighbour.cj >= IMPLICIT_GRID || refined[neighbour.cj][neighbour.ci] {
+				continue
+			}
+
+			//	check the edges of the refined grid along the side shared with the neighbour
+			for k := 0; k < IMPLICIT_REFINEMENT; k++ {
+				v1 := value[neighbour.j+k*neighbour.dj][neighbour.i+k*neighbour.di]
+				v2 := value[neighbour.j+(k+1)*neighbour.dj][neighbour.i+(k+1)*neighbour.di]
+
+				if !math.IsNaN(v1) && !math.IsNaN(v2) && (v1 >= 0) != (v2 >= 0) {
+					refined[neighbour.cj][neighbour.ci] = true
+					queue = append(queue, [2]int{neighbour.ci, neighbour.cj})
+					break
+				}
+			}
+		}
+	}
+
+	return ContourPaths(column, row, value, []float64{0}), nil
+}
+
+//	generateImplicit generate the curves of a relation, each one as a path
+func (set *Set_points_2d) generateImplicit(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	for _, line := range set.paths {
+		driver.BeginPath(colour)
+		for _, point := range line.Point {
+			driver.PointToPath(int64(x_scale.scale(point.X)), int64(y_scale.scale(point.Y)))
+		}
+		driver.EndPath()
+	}
+}
@@ -0,0 +1,113 @@
+////////////////////////////////////////////////////////////////////////////////
+//	implicit_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the implicit plots
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/aldebap/go-plot/expression"
+)
+
+//	TestImplicit unit tests for the implicit plots
+func TestImplicit(t *testing.T) {
+
+	t.Run(">>> implicitFunction: relations", func(t *testing.T) {
+
+		got, err := implicitFunction("x**2 + y**2 = 1")
+		//	check the result
+		if err != nil || got != "(x**2 + y**2)-(1)" {
+			t.Errorf("failed parsing relation: expected: (x**2 + y**2)-(1) result: %s %v", got, err)
+		}
+
+		for _, relation := range []string{"x + y", "x = y = 1", " = 1"} {
+			_, err := implicitFunction(relation)
+			if err == nil {
+				t.Errorf("failed parsing relation %s: expected an error", relation)
+			}
+		}
+	})
+
+	t.Run(">>> ImplicitPaths: unit circle", func(t *testing.T) {
+
+		got, err := ImplicitPaths("x**2 + y**2 = 1", expression.RADIANS, -2, 2, -2, 2)
+		if err != nil {
+			t.Errorf("fail tracing implicit relation: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if len(got) != 1 || !got[0].Closed() {
+			t.Errorf("failed tracing implicit relation: expected a closed curve result: %d curves", len(got))
+			return
+		}
+		for _, point := range got[0].Point {
+			if math.Abs(math.Hypot(point.X, point.Y)-1) > 1e-3 {
+				t.Errorf("failed tracing implicit relation: expected points in the unit circle result: %v", point)
+				return
+			}
+		}
+	})
+
+	t.Run(">>> ImplicitPaths: curve across the interval", func(t *testing.T) {
+
+		//	the hyperbola has a curve in the first and in the third quadrants
+		got, err := ImplicitPaths("x*y = 1", expression.RADIANS, -4, 4, -4, 4)
+		if err != nil {
+			t.Errorf("fail tracing implicit relation: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if len(got) != 2 || got[0].Closed() || got[1].Closed() {
+			t.Errorf("failed tracing implicit relation: expected two open curves result: %d curves", len(got))
+		}
+	})
+
+	t.Run(">>> ImplicitPaths: invalid relations", func(t *testing.T) {
+
+		for _, test := range []struct {
+			relation string
+			min_x    float64
+			want     string
+		}{
+			{relation: "x + y", min_x: -1, want: "implicit relation expected to be f(x,y) = g(x,y): x + y"},
+			{relation: "x = y", min_x: 1, want: "invalid range of samples: x = y"},
+		} {
+			_, err := ImplicitPaths(test.relation, expression.RADIANS, test.min_x, 1, -1, 1)
+			//	check the result
+			if err == nil || test.want != err.Error() {
+				t.Errorf("failed tracing implicit relation: expected error: %s result: %v", test.want, err)
+			}
+		}
+	})
+
+	t.Run(">>> generate: curves of a relation", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		plot := &Plot_2D{
+			Function: []Function_2d{{Title: "x*y = 1", Style: LINES, Function: "x*y = 1", Min_x: -4, Max_x: 4, Min_y: -4, Max_y: 4, Implicit: true}},
+		}
+
+		err := plot.generate(NewSVG_Driver(writer, &TerminalOptions{Width: 400, Height: 300}))
+		writer.Flush()
+		if err != nil {
+			t.Errorf("fail generating plot: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if strings.Count(output.String(), "<path") != 2 {
+			t.Errorf("failed generating plot: expected two paths result: %s", output.String())
+		}
+	})
+}
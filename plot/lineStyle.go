@@ -0,0 +1,304 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lineStyle.go  -  Oct-19-2026  -  aldebap
+//
+//	Line styles used to draw the series of a plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//	dash types for lines (gnuplot's dashtype numbers)
+const (
+	DASH_SOLID   uint8 = 1
+	DASH_DASHED  uint8 = 2
+	DASH_DOTTED  uint8 = 3
+	DASH_DOT     uint8 = 4
+	DASH_DOT_DOT uint8 = 5
+)
+
+//	sources of the colour of each point: the colour of the line, a column with the index of a line colour or a
+//	column mapped into the palette
+const (
+	COLOUR_FIXED    uint8 = 0
+	COLOUR_VARIABLE uint8 = 1
+	COLOUR_PALETTE  uint8 = 2
+)
+
+//	names of the sources of the colour of each point
+var Colour_source = map[string]uint8{
+	"fixed":    COLOUR_FIXED,
+	"variable": COLOUR_VARIABLE,
+	"palette":  COLOUR_PALETTE,
+}
+
+//	default values for line styles
+const (
+	DEFAULT_LINE_WIDTH = 1
+	DEFAULT_POINT_SIZE = 1
+)
+
+//	attributes used to draw a series (zero values means default ones, and the size and colour of each point can be
+//	given by extra columns)
+type Line_style struct {
+	Colour        *RGB_colour
+	Colour_source uint8
+	Width         float64
+	Dash_type     uint8
+	Point_type    uint8
+	Point_size    float64
+	Variable_size bool
+}
+
+//	colour return the colour of the style or the one from pallete for the series index
+func (style *Line_style) colour(index int) RGB_colour {
+	if style.Colour == nil {
+		return plotPallete[index%len(plotPallete)]
+	}
+
+	return *style.Colour
+}
+
+//	lineWidth return the width of the style or the default one
+func (style *Line_style) lineWidth() float64 {
+	if style.Width <= 0 {
+		return DEFAULT_LINE_WIDTH
+	}
+
+	return style.Width
+}
+
+//	dashType return the dash type of the style or the default one
+func (style *Line_style) dashType() uint8 {
+	if style.Dash_type == 0 {
+		return DASH_SOLID
+	}
+
+	return style.Dash_type
+}
+
+//	pointSize return the point size of the style or the default one
+func (style *Line_style) pointSize() float64 {
+	if style.Point_size <= 0 {
+		return DEFAULT_POINT_SIZE
+	}
+
+	return style.Point_size
+}
+
+//	variableColumns return the number of extra columns used by the size and the colour of each point
+func (style *Line_style) variableColumns() int {
+
+	columns := 0
+
+	if style.Variable_size {
+		columns++
+	}
+	if style.Colour_source != COLOUR_FIXED {
+		columns++
+	}
+
+	return columns
+}
+
+//	pointColour return the colour of a point given by a variable column or the colour of the set
+func (set *Set_points_2d) pointColour(point *Point_2d, colour RGB_colour) RGB_colour {
+
+	if point.Colour == nil {
+		return colour
+	}
+
+	switch set.Line_style.Colour_source {
+	case COLOUR_VARIABLE:
+		index := int(*point.Colour)
+		if index < 1 {
+			return colour
+		}
+		return plotPallete[(index-1)%len(plotPallete)]
+
+	case COLOUR_PALETTE:
+		if set.colours != nil {
+			return set.colours.colour(*point.Colour)
+		}
+	}
+
+	return colour
+}
+
+//	pointWidth return the width of the marker of a point given by a variable column or the width of the set
+func (set *Set_points_2d) pointWidth(point *Point_2d, width float64) float64 {
+
+	if !set.Line_style.Variable_size || point.Size == nil || *point.Size <= 0 {
+		return width
+	}
+
+	return POINT_WIDTH * *point.Size
+}
+
+//	dashPattern return the lengths of dashes and gaps for a dash type (nil for solid lines)
+func dashPattern(dashType uint8, width float64) []float64 {
+
+	var pattern []float64
+
+	switch dashType {
+	case DASH_DASHED:
+		pattern = []float64{8, 4}
+
+	case DASH_DOTTED:
+		pattern = []float64{2, 4}
+
+	case DASH_DOT:
+		pattern = []float64{8, 4, 2, 4}
+
+	case DASH_DOT_DOT:
+		pattern = []float64{8, 4, 2, 4, 2, 4}
+
+	default:
+		return nil
+	}
+
+	//	wider lines require longer dashes to keep them visible
+	if width > 1 {
+		for i := range pattern {
+			pattern[i] *= width
+		}
+	}
+
+	return pattern
+}
+
+//	parseLineStyleOption parse a line style option in the beginning of options and return the number of chars consumed
+func parseLineStyleOption(style *Line_style, options string, definedStyles map[uint8]Line_style) (int, error) {
+
+	lineStyleOptionRegEx, err := regexp.Compile(`^\s*(linecolor|lc|linewidth|lw|dashtype|dt|pointtype|pt|pointsize|ps|linestyle|ls)\s+((rgb\s+){0,1}"([^"]+)"|([-+]{0,1}[0-9.]+)|(variable|palette(\s+z){0,1})\b)\s*`)
+	if err != nil {
+		return 0, err
+	}
+
+	match := lineStyleOptionRegEx.FindAllStringSubmatch(options, -1)
+	if len(match) != 1 {
+		return 0, nil
+	}
+
+	name := match[0][1]
+	quoted := match[0][4]
+	number := match[0][5]
+	keyword := match[0][6]
+
+	//	only colours are described by strings
+	if len(quoted) > 0 && name != "linecolor" && name != "lc" {
+		return 0, errors.New("numeric value expected for " + name + ": " + quoted)
+	}
+
+	//	colours can be variable or mapped into the palette, and sizes can be variable
+	if len(keyword) > 0 {
+		switch {
+		case name == "linecolor" || name == "lc":
+			style.Colour = nil
+			style.Colour_source = COLOUR_VARIABLE
+			if strings.HasPrefix(keyword, "palette") {
+				style.Colour_source = COLOUR_PALETTE
+			}
+
+		case (name == "pointsize" || name == "ps") && keyword == "variable":
+			style.Variable_size = true
+
+		default:
+			return 0, errors.New("invalid value for " + name + ": " + keyword)
+		}
+
+		return len(match[0][0]), nil
+	}
+
+	switch name {
+	case "linecolor", "lc":
+		style.Colour_source = COLOUR_FIXED
+		if len(quoted) > 0 {
+			colour, err := ParseColour(quoted)
+			if err != nil {
+				return 0, err
+			}
+			style.Colour = &colour
+		} else {
+			index, err := strconv.ParseUint(number, 10, 8)
+			if err != nil || index == 0 {
+				return 0, errors.New("invalid line colour index: " + number)
+			}
+			colour := plotPallete[(int(index)-1)%len(plotPallete)]
+			style.Colour = &colour
+		}
+
+	case "linewidth", "lw":
+		width, err := strconv.ParseFloat(number, 64)
+		if err != nil || width <= 0 {
+			return 0, errors.New("line width expected to be a positive number: " + number)
+		}
+		style.Width = width
+
+	case "dashtype", "dt":
+		dashType, err := strconv.ParseUint(number, 10, 8)
+		if err != nil || dashType < uint64(DASH_SOLID) || dashType > uint64(DASH_DOT_DOT) {
+			return 0, errors.New("invalid dash type: " + number)
+		}
+		style.Dash_type = uint8(dashType)
+
+	case "pointtype", "pt":
+		pointType, err := strconv.ParseUint(number, 10, 8)
+		if err != nil {
+			return 0, errors.New("invalid point type: " + number)
+		}
+		style.Point_type = uint8(pointType)
+
+	case "pointsize", "ps":
+		size, err := strconv.ParseFloat(number, 64)
+		if err != nil || size <= 0 {
+			return 0, errors.New("point size expected to be a positive number: " + number)
+		}
+		style.Point_size = size
+		style.Variable_size = false
+
+	case "linestyle", "ls":
+		index, err := strconv.ParseUint(number, 10, 8)
+		if err != nil {
+			return 0, errors.New("invalid line style: " + number)
+		}
+
+		//	a line style replaces every attribute set before it
+		definedStyle, found := definedStyles[uint8(index)]
+		if !found {
+			return 0, errors.New("undefined line style: " + number)
+		}
+		*style = definedStyle
+	}
+
+	return len(match[0][0]), nil
+}
+
+//	parseLineStyle parse a list of line style options
+func parseLineStyle(options string, definedStyles map[uint8]Line_style) (*Line_style, error) {
+
+	var style Line_style
+
+	for {
+		if len(options) == 0 {
+			break
+		}
+
+		length, err := parseLineStyleOption(&style, options, definedStyles)
+		if err != nil {
+			return nil, err
+		}
+		if length == 0 {
+			return nil, errors.New("invalid line style option: " + options)
+		}
+
+		options = options[length:]
+	}
+
+	return &style, nil
+}
@@ -0,0 +1,109 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lineStyle_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for line styles
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"reflect"
+	"testing"
+)
+
+//	TestParseLineStyle unit tests for parseLineStyle()
+func TestParseLineStyle(t *testing.T) {
+
+	t.Run(">>> parseLineStyle: every option", func(t *testing.T) {
+
+		got, err := parseLineStyle(`linecolor rgb "blue" linewidth 2.5 dashtype 3 pointtype 4 pointsize 2`, nil)
+		if err != nil {
+			t.Errorf("fail parsing line style: %s", err.Error())
+			return
+		}
+
+		want := Line_style{Colour: &BLUE, Width: 2.5, Dash_type: DASH_DOTTED, Point_type: 4, Point_size: 2}
+		//	check the result
+		if !reflect.DeepEqual(want, *got) {
+			t.Errorf("failed parsing line style: expected: %v result: %v", want, *got)
+		}
+	})
+
+	t.Run(">>> parseLineStyle: colour index", func(t *testing.T) {
+
+		got, err := parseLineStyle(`lc 2`, nil)
+		if err != nil {
+			t.Errorf("fail parsing line style: %s", err.Error())
+			return
+		}
+
+		want := plotPallete[1]
+		//	check the result
+		if got.Colour == nil || want != *got.Colour {
+			t.Errorf("failed parsing line style: expected colour: %v result: %v", want, got.Colour)
+		}
+	})
+
+	t.Run(">>> parseLineStyle: variable size and palette colour", func(t *testing.T) {
+
+		got, err := parseLineStyle(`lc rgb "red" pointsize variable lc palette z`, nil)
+		if err != nil {
+			t.Errorf("fail parsing line style: %s", err.Error())
+			return
+		}
+
+		want := Line_style{Colour_source: COLOUR_PALETTE, Variable_size: true}
+		//	check the result
+		if !reflect.DeepEqual(want, *got) || got.variableColumns() != 2 {
+			t.Errorf("failed parsing line style: expected: %v result: %v", want, *got)
+		}
+	})
+
+	t.Run(">>> parseLineStyle: invalid variable option", func(t *testing.T) {
+		want := "invalid value for lw: variable"
+
+		_, err := parseLineStyle(`lw variable`, nil)
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing line style: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> parseLineStyle: invalid option", func(t *testing.T) {
+		want := "numeric value expected for lw: thick"
+
+		_, err := parseLineStyle(`lw "thick"`, nil)
+		if err == nil {
+			t.Errorf("error expected parsing line style")
+			return
+		}
+
+		got := err.Error()
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing line style: expected error: %s result: %s", want, got)
+		}
+	})
+}
+
+//	TestDashPattern unit tests for dashPattern()
+func TestDashPattern(t *testing.T) {
+
+	t.Run(">>> dashPattern: solid line", func(t *testing.T) {
+
+		got := dashPattern(DASH_SOLID, 2)
+		//	check the result
+		if got != nil {
+			t.Errorf("failed evaluating dash pattern: expected: nil result: %v", got)
+		}
+	})
+
+	t.Run(">>> dashPattern: scaled by line width", func(t *testing.T) {
+
+		want := []float64{16, 8, 4, 8}
+		got := dashPattern(DASH_DOT, 2)
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed evaluating dash pattern: expected: %v result: %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+////////////////////////////////////////////////////////////////////////////////
+//	marker.go  -  Oct-19-2026  -  aldebap
+//
+//	Marker shapes used to draw the points of a plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import "math"
+
+//	point types (gnuplot's pointtype numbers)
+const (
+	POINT_AUTO                     uint8 = 0
+	POINT_PLUS                     uint8 = 1
+	POINT_CROSS                    uint8 = 2
+	POINT_STAR                     uint8 = 3
+	POINT_OPEN_SQUARE              uint8 = 4
+	POINT_FILLED_SQUARE            uint8 = 5
+	POINT_OPEN_CIRCLE              uint8 = 6
+	POINT_FILLED_CIRCLE            uint8 = 7
+	POINT_OPEN_TRIANGLE            uint8 = 8
+	POINT_FILLED_TRIANGLE          uint8 = 9
+	POINT_OPEN_INVERTED_TRIANGLE   uint8 = 10
+	POINT_FILLED_INVERTED_TRIANGLE uint8 = 11
+	POINT_OPEN_DIAMOND             uint8 = 12
+	POINT_FILLED_DIAMOND           uint8 = 13
+	POINT_OPEN_PENTAGON            uint8 = 14
+	POINT_FILLED_PENTAGON          uint8 = 15
+)
+
+//	number of point types
+const (
+	POINT_TYPES = 15
+)
+
+//	pointType return the point type of the style or one chosen from the series index
+func (style *Line_style) pointType(index int) uint8 {
+	if style.Point_type == POINT_AUTO {
+		return uint8(index%POINT_TYPES) + 1
+	}
+
+	//	like gnuplot, point types cycle after the last one
+	return (style.Point_type-1)%POINT_TYPES + 1
+}
+
+//	drawMarker draw the marker of a point type centered in a point using a given width
+func drawMarker(driver GraphicsDriver, x, y float64, pointType uint8, width float64, colour RGB_colour) {
+
+	half := width / 2
+
+	switch pointType {
+	case POINT_PLUS:
+		driver.Line(int64(x-half), int64(y), int64(x+half), int64(y), colour)
+		driver.Line(int64(x), int64(y-half), int64(x), int64(y+half), colour)
+
+	case POINT_CROSS:
+		driver.Line(int64(x-half), int64(y-half), int64(x+half), int64(y+half), colour)
+		driver.Line(int64(x-half), int64(y+half), int64(x+half), int64(y-half), colour)
+
+	case POINT_STAR:
+		drawMarker(driver, x, y, POINT_PLUS, width, colour)
+		drawMarker(driver, x, y, POINT_CROSS, width, colour)
+
+	case POINT_OPEN_SQUARE, POINT_FILLED_SQUARE:
+		driver.Polygon(regularPolygon(x, y, half*math.Sqrt2, 4, math.Pi/4), colour, pointType == POINT_FILLED_SQUARE)
+
+	case POINT_OPEN_CIRCLE, POINT_FILLED_CIRCLE:
+		driver.Circle(int64(x), int64(y), int64(math.Round(half)), colour, pointType == POINT_FILLED_CIRCLE)
+
+	case POINT_OPEN_TRIANGLE, POINT_FILLED_TRIANGLE:
+		driver.Polygon(regularPolygon(x, y, half, 3, math.Pi/2), colour, pointType == POINT_FILLED_TRIANGLE)
+
+	case POINT_OPEN_INVERTED_TRIANGLE, POINT_FILLED_INVERTED_TRIANGLE:
+		driver.Polygon(regularPolygon(x, y, half, 3, -math.Pi/2), colour, pointType == POINT_FILLED_INVERTED_TRIANGLE)
+
+	case POINT_OPEN_DIAMOND, POINT_FILLED_DIAMOND:
+		driver.Polygon(regularPolygon(x, y, half, 4, 0), colour, pointType == POINT_FILLED_DIAMOND)
+
+	case POINT_OPEN_PENTAGON, POINT_FILLED_PENTAGON:
+		driver.Polygon(regularPolygon(x, y, half, 5, math.Pi/2), colour, pointType == POINT_FILLED_PENTAGON)
+	}
+}
+
+//	regularPolygon return the vertices of a regular polygon with a vertex in a given angle
+func regularPolygon(x, y, radius float64, sides int, angle float64) []DriverPoint {
+
+	vertex := make([]DriverPoint, sides)
+
+	for i := range vertex {
+		vertexAngle := angle + 2*math.Pi*float64(i)/float64(sides)
+
+		vertex[i] = DriverPoint{
+			X: int64(math.Round(x + radius*math.Cos(vertexAngle))),
+			Y: int64(math.Round(y + radius*math.Sin(vertexAngle))),
+		}
+	}
+
+	return vertex
+}
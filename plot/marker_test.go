@@ -0,0 +1,65 @@
+////////////////////////////////////////////////////////////////////////////////
+//	marker_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for marker shapes
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+//	TestPointType unit tests for pointType()
+func TestPointType(t *testing.T) {
+
+	t.Run(">>> pointType: automatic shape for each series", func(t *testing.T) {
+
+		var style Line_style
+
+		want := []uint8{POINT_PLUS, POINT_CROSS, POINT_STAR, POINT_OPEN_SQUARE}
+		got := []uint8{style.pointType(0), style.pointType(1), style.pointType(2), style.pointType(3)}
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed choosing point type: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> pointType: explicit shape cycles after the last one", func(t *testing.T) {
+
+		style := Line_style{Point_type: POINT_TYPES + 7}
+
+		want := POINT_FILLED_CIRCLE
+		got := style.pointType(2)
+		//	check the result
+		if want != got {
+			t.Errorf("failed choosing point type: expected: %d result: %d", want, got)
+		}
+	})
+}
+
+//	TestRegularPolygon unit tests for regularPolygon()
+func TestRegularPolygon(t *testing.T) {
+
+	t.Run(">>> regularPolygon: diamond", func(t *testing.T) {
+
+		want := []DriverPoint{{X: 14, Y: 10}, {X: 10, Y: 14}, {X: 6, Y: 10}, {X: 10, Y: 6}}
+		got := regularPolygon(10, 10, 4, 4, 0)
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed evaluating polygon: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> regularPolygon: square", func(t *testing.T) {
+
+		want := []DriverPoint{{X: 14, Y: 14}, {X: 6, Y: 14}, {X: 6, Y: 6}, {X: 14, Y: 6}}
+		got := regularPolygon(10, 10, 4*math.Sqrt2, 4, math.Pi/4)
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed evaluating polygon: expected: %v result: %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+////////////////////////////////////////////////////////////////////////////////
+//	multiplot.go  -  Oct-19-2026  -  aldebap
+//
+//	Generate a layout of several 2D Go-Plots in a single output
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+//	attributes used to describe a multiplot (zero rows or columns means a single column layout)
+type Multiplot struct {
+	Title            string
+	Rows             int
+	Columns          int
+	Panels           []*Plot_2D
+	Width            int64
+	Height           int64
+	Terminal         uint8
+	Terminal_options TerminalOptions
+	output           string
+}
+
+//	GetOutputFileName return the multiplot's output file name
+func (m *Multiplot) GetOutputFileName() string {
+	return m.output
+}
+
+//	nextPanel return a plot with the settings of a panel to be used by the next one
+func (p *Plot_2D) nextPanel() *Plot_2D {
+
+	next := *p
+
+	next.Set_points = make([]Set_points_2d, 0)
+	next.Function = make([]Function_2d, 0)
+
+	//	lists are copied to keep the panels independent
+	next.Annotations = append([]Annotation(nil), p.Annotations...)
+	next.Reference_lines = append([]Reference_line(nil), p.Reference_lines...)
+	next.Reference_bands = append([]Reference_band(nil), p.Reference_bands...)
+
+	return &next
+}
+
+//	layout return the number of rows and columns of the multiplot
+func (m *Multiplot) layout() (rows, columns int) {
+	if m.Rows <= 0 || m.Columns <= 0 {
+		return len(m.Panels), 1
+	}
+
+	return m.Rows, m.Columns
+}
+
+//	GeneratePlot implementation of multiplot generation
+func (m *Multiplot) GeneratePlot(plotWriter *bufio.Writer) error {
+
+	//	create the graphics driver
+	driver := newGraphicsDriver(m.Terminal, &m.Terminal_options, plotWriter)
+	defer driver.Close()
+
+	//	check if there are panels to be generated and if they fit in the layout
+	if len(m.Panels) == 0 {
+		return errors.New("no plots in the multiplot")
+	}
+
+	rows, columns := m.layout()
+
+	if len(m.Panels) > rows*columns {
+		return errors.New("too many plots for the multiplot layout: " + strconv.Itoa(len(m.Panels)) + " plots in " +
+			strconv.Itoa(rows) + "," + strconv.Itoa(columns))
+	}
+
+	//	get multiplot dimention from driver's default or from multiplot parameters when present
+	width, height := driver.GetDimensions()
+
+	if m.Width > 0 {
+		width = m.Width
+	}
+	if m.Height > 0 {
+		height = m.Height
+	}
+
+	err := driver.SetDimensions(width, height)
+	if err != nil {
+		return errors.New("error setting plot dimentions: " + err.Error())
+	}
+
+	fontFamily, fontSize := driver.GetFont()
+
+	err = driver.SetFont(fontFamily, fontSize)
+	if err != nil {
+		return errors.New("error setting plot font: " + err.Error())
+	}
+
+	//	the title is placed above the panels
+	panelsHeight := height
+
+	if len(m.Title) > 0 {
+		textWidth, textHeight := driver.GetTextBox(m.Title)
+
+		panelsHeight -= textHeight + 2*TITLE_MARGIN
+		driver.Text(width/2-textWidth/2, height-TITLE_MARGIN-textHeight, 0, m.Title, BLACK)
+	}
+
+	//	generate each panel in it's viewport, filling the layout by rows from the top
+	panelWidth := width / int64(columns)
+	panelHeight := panelsHeight / int64(rows)
+
+	for i, panel := range m.Panels {
+		row := int64(i / columns)
+		column := int64(i % columns)
+
+		driver.Comment(fmt.Sprintf("multiplot panel #%d", i+1))
+
+		viewport := NewViewport_Driver(driver, column*panelWidth, panelsHeight-(row+1)*panelHeight, panelWidth, panelHeight)
+
+		//	the dimensions of a panel are given by the layout
+		panelPlot := *panel
+		panelPlot.Width = 0
+		panelPlot.Height = 0
+
+		err = panelPlot.generate(viewport)
+		if err != nil {
+			return errors.New("error generating plot #" + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+////////////////////////////////////////////////////////////////////////////////
+//	multiplot_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the generation of multiplots
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestMultiplot unit tests for Multiplot.GeneratePlot() and the viewport driver
+func TestMultiplot(t *testing.T) {
+
+	t.Run(">>> GeneratePlot: too many plots for the layout", func(t *testing.T) {
+
+		panel := &Plot_2D{Function: []Function_2d{{Function: "x", Min_x: 0, Max_x: 1}}}
+		multiplot := &Multiplot{Rows: 1, Columns: 2, Panels: []*Plot_2D{panel, panel, panel}, Terminal: TERMINAL_SVG}
+
+		var output bytes.Buffer
+
+		want := "too many plots for the multiplot layout: 3 plots in 1,2"
+		err := multiplot.GeneratePlot(bufio.NewWriter(&output))
+		//	check the result
+		if err == nil || err.Error() != want {
+			t.Errorf("failed generating multiplot: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> Viewport_Driver: coordinates translated into the viewport", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		viewport := NewViewport_Driver(driver, 50, 0, 50, 50)
+		viewport.Line(0, 0, 10, 10, BLACK)
+		writer.Flush()
+
+		want := `<line x1="50" y1="100" x2="60" y2="90"`
+		//	check the result
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("failed translating coordinates: expected: %s result: %s", want, output.String())
+		}
+
+		width, height := viewport.GetDimensions()
+		if width != 50 || height != 50 {
+			t.Errorf("failed getting viewport dimensions: expected: 50, 50 result: %d, %d", width, height)
+		}
+	})
+
+	t.Run(">>> nextPanel: settings kept and series removed", func(t *testing.T) {
+
+		panel := &Plot_2D{
+			X_label:     "time",
+			Annotations: []Annotation{{Type: ANNOTATION_LABEL, Text: "a"}},
+			Function:    []Function_2d{{Function: "x"}},
+		}
+
+		next := panel.nextPanel()
+		next.Annotations[0].Text = "b"
+		//	check the result
+		if next.X_label != "time" || len(next.Function) != 0 || panel.Annotations[0].Text != "a" {
+			t.Errorf("failed creating next panel: result: %v", next)
+		}
+	})
+}
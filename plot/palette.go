@@ -0,0 +1,258 @@
+////////////////////////////////////////////////////////////////////////////////
+//	palette.go  -  Oct-19-2026  -  aldebap
+//
+//	Palette used to map values into colours and the colour box showing it
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//	dimensions in pixels of the colour box, drawn at the right of the plot
+const (
+	COLOUR_BOX_AREA  = 80
+	COLOUR_BOX_WIDTH = 16
+	COLOUR_BOX_STRIP = 2
+
+	MIN_CB_SCALE_DIVISIONS = 5
+	MAX_CB_SCALE_DIVISIONS = 10
+)
+
+//	colour of a palette in a given value
+type Palette_colour struct {
+	Value  float64
+	Colour RGB_colour
+}
+
+//	attributes used to describe a palette (the colours are interpolated between the defined ones, using gnuplot's
+//	default rgbformulae 7,5,15 when no colour is defined)
+type Palette struct {
+	Defined []Palette_colour
+}
+
+//	Validate check if the palette has at least two colours in ascending order of their values
+func (palette *Palette) Validate() error {
+
+	if len(palette.Defined) == 0 {
+		return nil
+	}
+	if len(palette.Defined) < 2 {
+		return errors.New("a palette requires at least two colours")
+	}
+
+	for i := 1; i < len(palette.Defined); i++ {
+		if palette.Defined[i].Value < palette.Defined[i-1].Value {
+			return errors.New("palette values expected to be in ascending order")
+		}
+	}
+	if palette.Defined[0].Value == palette.Defined[len(palette.Defined)-1].Value {
+		return errors.New("palette values expected to be in ascending order")
+	}
+
+	return nil
+}
+
+//	colour return the colour of the palette in a fraction of it's range
+func (palette *Palette) colour(fraction float64) RGB_colour {
+
+	fraction = math.Max(0, math.Min(1, fraction))
+
+	if len(palette.Defined) == 0 {
+		return RGB_colour{
+			red:   colourComponent(math.Sqrt(fraction)),
+			green: colourComponent(fraction * fraction * fraction),
+			blue:  colourComponent(math.Sin(2 * math.Pi * fraction)),
+		}
+	}
+
+	first := palette.Defined[0]
+	last := palette.Defined[len(palette.Defined)-1]
+	value := first.Value + fraction*(last.Value-first.Value)
+
+	for i := 1; i < len(palette.Defined); i++ {
+		from, to := palette.Defined[i-1], palette.Defined[i]
+		if value > to.Value {
+			continue
+		}
+
+		//	equal values make a sharp change of colour
+		if to.Value == from.Value {
+			return to.Colour
+		}
+
+		return mixColours(from.Colour, to.Colour, (value-from.Value)/(to.Value-from.Value))
+	}
+
+	return last.Colour
+}
+
+//	colourComponent convert a fraction into a component of a colour
+func colourComponent(fraction float64) uint8 {
+	return uint8(255*math.Max(0, math.Min(1, fraction)) + 0.5)
+}
+
+//	mixColours interpolate two colours
+func mixColours(from, to RGB_colour, fraction float64) RGB_colour {
+
+	mix := func(f, t uint8) uint8 {
+		return uint8(float64(f) + fraction*(float64(t)-float64(f)) + 0.5)
+	}
+
+	return RGB_colour{red: mix(from.red, to.red), green: mix(from.green, to.green), blue: mix(from.blue, to.blue)}
+}
+
+//	transformation of the values of the colour axis into the colours of the palette
+type colourMap struct {
+	palette *Palette
+	scale   *axisScale
+}
+
+//	colour return the colour of the palette for a value
+func (colours *colourMap) colour(value float64) RGB_colour {
+	return colours.palette.colour(colours.scale.scale(value))
+}
+
+//	colourMap return the transformation of the colour axis, ranging the values mapped into the palette by the sets
+//	(nil when no set is coloured by the palette)
+func (p *Plot_2D) colourMap(series ...[]Set_points_2d) (*colourMap, error) {
+
+	var cb_range axisRange
+
+	for _, set_points := range series {
+		for _, set := range set_points {
+			if !set.paletteColours() {
+				continue
+			}
+
+			for _, point := range set.Point {
+				if point.Colour != nil && p.Cb_axis.valid(*point.Colour) {
+					cb_range.extend(*point.Colour, *point.Colour)
+				}
+			}
+		}
+	}
+	if !cb_range.used {
+		return nil, nil
+	}
+
+	var err error
+
+	cb_range.min, cb_range.max = p.Cb_axis.roundRange(cb_range.min, cb_range.max, MIN_CB_SCALE_DIVISIONS)
+	cb_range.min, cb_range.max, err = p.Cb_axis.limits(cb_range.min, cb_range.max)
+	if err != nil {
+		return nil, err
+	}
+
+	return &colourMap{
+		palette: &p.Palette,
+		scale:   newAxisScale(&p.Cb_axis, cb_range.min, cb_range.max, 0, 1),
+	}, nil
+}
+
+//	generateColourBox generate a bar with the colours of the palette and the scale of the colour axis at the right
+//	side of the graphic
+func (colours *colourMap) generateColourBox(driver GraphicsDriver, width, height int64) {
+
+	driver.Comment("colour box")
+
+	left := width - COLOUR_BOX_AREA
+	right := left + COLOUR_BOX_WIDTH
+	bottom := int64(Y_MARGINS)
+	top := height - int64(Y_MARGINS)
+
+	//	the bar is painted in strips with the colour of their middle
+	strips := (top - bottom) / COLOUR_BOX_STRIP
+	if strips < 1 {
+		strips = 1
+	}
+
+	pixel := make([][]RGB_colour, strips)
+	for i := range pixel {
+		pixel[i] = []RGB_colour{colours.palette.colour((float64(i) + 0.5) / float64(strips))}
+	}
+
+	driver.Image(left, bottom, right-left, top-bottom, pixel)
+	driver.Polygon([]DriverPoint{{X: left, Y: bottom}, {X: right, Y: bottom}, {X: right, Y: top}, {X: left, Y: top}}, BLACK, false)
+
+	//	the scale of the colour axis is at the right of the bar
+	cb_scale := newAxisScale(colours.scale.axis, colours.scale.min, colours.scale.max, Y_MARGINS, float64(height)-2*Y_MARGINS)
+
+	for _, tick := range cb_scale.ticks(MIN_CB_SCALE_DIVISIONS, MAX_CB_SCALE_DIVISIONS) {
+		scaled_y := int64(cb_scale.scale(tick.value))
+		tickWidth := int64(SCALE_WIDTH)
+
+		if tick.minor {
+			tickWidth /= 2
+		}
+
+		driver.Line(right-tickWidth, scaled_y, right, scaled_y, BLACK)
+
+		if len(tick.label) > 0 {
+			_, textHeight := driver.GetTextBox(tick.label)
+
+			driver.Text(right+SCALE_WIDTH, scaled_y-textHeight/2, 0, tick.label, BLACK)
+		}
+	}
+}
+
+//	parsePalette parse the options of a set palette command: [defined (value "colour", ...)|gray|color]
+func parsePalette(options string) (*Palette, error) {
+
+	options = strings.TrimSpace(options)
+
+	switch options {
+	case "", "color", "colour":
+		return &Palette{}, nil
+
+	case "gray", "grey":
+		return &Palette{Defined: []Palette_colour{{Value: 0, Colour: BLACK}, {Value: 1, Colour: WHITE}}}, nil
+	}
+
+	definedRegEx, err := regexp.Compile(`^defined\s*\((.*)\)$`)
+	if err != nil {
+		return nil, err
+	}
+
+	colourRegEx, err := regexp.Compile(`^\s*([-+]{0,1}[0-9.]+)\s+(rgb\s+){0,1}"([^"]+)"\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	match := definedRegEx.FindAllStringSubmatch(options, -1)
+	if len(match) != 1 {
+		return nil, errors.New("invalid palette: " + options)
+	}
+
+	var palette Palette
+
+	for _, definition := range strings.Split(match[0][1], ",") {
+		colourMatch := colourRegEx.FindAllStringSubmatch(definition, -1)
+		if len(colourMatch) != 1 {
+			return nil, errors.New("invalid palette colour: " + strings.TrimSpace(definition))
+		}
+
+		value, err := strconv.ParseFloat(colourMatch[0][1], 64)
+		if err != nil {
+			return nil, errors.New("invalid palette value: " + colourMatch[0][1])
+		}
+		colour, err := ParseColour(colourMatch[0][3])
+		if err != nil {
+			return nil, err
+		}
+
+		palette.Defined = append(palette.Defined, Palette_colour{Value: value, Colour: colour})
+	}
+
+	err = palette.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &palette, nil
+}
@@ -0,0 +1,104 @@
+////////////////////////////////////////////////////////////////////////////////
+//	palette_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for palettes and the colour box
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestPalette unit tests for palettes
+func TestPalette(t *testing.T) {
+
+	t.Run(">>> parsePalette: defined colours", func(t *testing.T) {
+
+		palette, err := parsePalette(`defined ( 0 "blue", 0.5 rgb "#ffffff", 1 "red" )`)
+		if err != nil {
+			t.Errorf("fail parsing palette: %s", err.Error())
+			return
+		}
+
+		want := RGB_colour{red: 255, green: 128, blue: 128}
+		got := palette.colour(0.75)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing palette: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> parsePalette: descending values", func(t *testing.T) {
+		want := "palette values expected to be in ascending order"
+
+		_, err := parsePalette(`defined (1 "blue", 0 "red")`)
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing palette: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> colour: default palette", func(t *testing.T) {
+
+		var palette Palette
+
+		//	check the result
+		if palette.colour(0) != BLACK || palette.colour(1) != (RGB_colour{red: 255, green: 255, blue: 0}) {
+			t.Errorf("failed evaluating default palette: expected black to yellow result: %v %v", palette.colour(0), palette.colour(1))
+		}
+	})
+
+	t.Run(">>> colourMap: range of the values in the palette", func(t *testing.T) {
+
+		low, high := 2.0, 10.0
+		plot := Plot_2D{Palette: Palette{Defined: []Palette_colour{{Value: 0, Colour: BLACK}, {Value: 1, Colour: WHITE}}}}
+
+		colours, err := plot.colourMap([]Set_points_2d{
+			{Point: []Point_2d{{X: 1, Y: 1, Colour: &low}}},
+			{Line_style: Line_style{Colour_source: COLOUR_PALETTE}, Point: []Point_2d{{X: 1, Y: 1, Colour: &low}, {X: 2, Y: 2, Colour: &high}}},
+		})
+		if err != nil || colours == nil {
+			t.Errorf("fail evaluating the colour map: %v", err)
+			return
+		}
+
+		//	check the result
+		if colours.scale.min != 2 || colours.scale.max != 10 || colours.colour(10) != WHITE {
+			t.Errorf("failed evaluating the colour map: expected range 2:10 result: %f:%f", colours.scale.min, colours.scale.max)
+		}
+	})
+
+	t.Run(">>> generate: colour box at the right of the plot", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		value := 5.0
+		plot := Plot_2D{
+			Set_points: []Set_points_2d{{
+				Style:      CIRCLES,
+				Line_style: Line_style{Colour_source: COLOUR_PALETTE},
+				Point:      []Point_2d{{X: 1, Y: 1, Colour: &value}},
+			}},
+			Width:  400,
+			Height: 300,
+		}
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 400, Height: 300})
+
+		err := plot.generate(driver)
+		writer.Flush()
+		if err != nil {
+			t.Errorf("fail generating plot: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if !strings.Contains(output.String(), "colour box") {
+			t.Errorf("failed generating plot: expected a colour box result: %s", output.String())
+		}
+	})
+}
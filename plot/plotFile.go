@@ -10,9 +10,13 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
+
+	"github.com/aldebap/go-plot/expression"
 )
 
 //	terminal descriptions for a plot
@@ -29,16 +33,38 @@ var (
 //	style descriptions for a plot of points
 var (
 	Style = map[string]uint8{
-		"boxes":       BOXES,
-		"dots":        DOTS,
-		"lines":       LINES,
-		"linespoints": LINES_POINTS,
-		"points":      POINTS,
+		"boxes":        BOXES,
+		"dots":         DOTS,
+		"lines":        LINES,
+		"linespoints":  LINES_POINTS,
+		"points":       POINTS,
+		"yerrorbars":   Y_ERROR_BARS,
+		"xerrorbars":   X_ERROR_BARS,
+		"xyerrorbars":  XY_ERROR_BARS,
+		"yerrorlines":  Y_ERROR_LINES,
+		"boxerrorbars": BOX_ERROR_BARS,
+		"filledcurves": FILLED_CURVES,
+		"histograms":   HISTOGRAMS,
+		"candlesticks": CANDLESTICKS,
+		"financebars":  FINANCEBARS,
+		"impulses":     IMPULSES,
+		"steps":        STEPS,
+		"fsteps":       FSTEPS,
+		"fillsteps":    FILLSTEPS,
+		"histeps":      HISTEPS,
+		"boxplot":      BOXPLOT,
+		"violin":       VIOLIN,
+		"vectors":      VECTORS,
+		"circles":      CIRCLES,
+		"image":        IMAGE,
+		"contours":     CONTOURS,
 	}
 )
 
 const (
 	DEFAULT_STYLE = "points"
+	DEFAULT_MIN_T = -5
+	DEFAULT_MAX_T = 5
 )
 
 //	TODO: refactor this entire function
@@ -48,17 +74,17 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 	//	compile all regexs required to parse the plot file
 	var err error
 
-	setXLabelRegEx, err := regexp.Compile(`^\s*set\s+xlabel\s+"(.+)"\s*$`)
+	setAxisLabelRegEx, err := regexp.Compile(`^\s*set\s+([xy]2{0,1}|z)label\s+"(.+)"\s*$`)
 	if err != nil {
 		return nil, err
 	}
 
-	setYLabelRegEx, err := regexp.Compile(`^\s*set\s+ylabel\s+"(.+)"\s*$`)
+	setRangeRegEx, err := regexp.Compile(`^\s*set\s+([xy]2{0,1}|cb|z)range\s+\[\s*([^:\]]*?)\s*:\s*([^:\]]*?)\s*\]\s*$`)
 	if err != nil {
 		return nil, err
 	}
 
-	setTerminalRegEx, err := regexp.Compile(`^\s*set\s+terminal\s+(\S+)\s*$`)
+	setTerminalRegEx, err := regexp.Compile(`^\s*set\s+terminal\s+(\S+)\s*(.*)$`)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +94,178 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 		return nil, err
 	}
 
-	plotCommandRegEx, err := regexp.Compile(`^\s*plot\s*`)
+	setLogScaleRegEx, err := regexp.Compile(`^\s*set\s+logscale(\s+([a-z][a-z0-9]*)){0,1}(\s+([0-9.]+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	unsetLogScaleRegEx, err := regexp.Compile(`^\s*unset\s+logscale(\s+([a-z][a-z0-9]*)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setTicsRegEx, err := regexp.Compile(`^\s*set\s+([xy]2{0,1}|cb|z)tics(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setMinorTicsRegEx, err := regexp.Compile(`^\s*set\s+m([xy]2{0,1}|z)tics(\s+(\d+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	unsetMinorTicsRegEx, err := regexp.Compile(`^\s*unset\s+m([xy]2{0,1}|z)tics\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setFormatRegEx, err := regexp.Compile(`^\s*set\s+format(\s+([xy2]+)){0,1}(\s+"([^"]*)"){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setGridRegEx, err := regexp.Compile(`^\s*set\s+grid(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	unsetGridRegEx, err := regexp.Compile(`^\s*unset\s+grid\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setStyleCandlesticksRegEx, err := regexp.Compile(`^\s*set\s+style\s+candlesticks((\s+(rising|falling)\s+(rgb\s+){0,1}"[^"]+")*)\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setStyleBoxplotRegEx, err := regexp.Compile(`^\s*set\s+style\s+boxplot(\s+range\s+([0-9.]+)){0,1}(\s+(outliers|nooutliers)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setStyleHistogramRegEx, err := regexp.Compile(`^\s*set\s+style\s+histogram(\s+([a-z]+)){0,1}(\s+gap\s+([0-9.]+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setPaletteRegEx, err := regexp.Compile(`^\s*set\s+palette(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setColourBoxRegEx, err := regexp.Compile(`^\s*(set|unset)\s+colorbox\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setIsoSamplesRegEx, err := regexp.Compile(`^\s*set\s+isosamples\s+(\d+)(\s*,\s*(\d+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setSamplesRegEx, err := regexp.Compile(`^\s*set\s+samples\s+(\d+)(\s*,\s*(\d+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setContourParamRegEx, err := regexp.Compile(`^\s*set\s+cntrparam(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setContourLabelRegEx, err := regexp.Compile(`^\s*(set|unset)\s+cntrlabel\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setBarsRegEx, err := regexp.Compile(`^\s*(set|unset)\s+bars(\s+(\S+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setZeroAxisRegEx, err := regexp.Compile(`^\s*set\s+([xy]{0,1})zeroaxis(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	unsetZeroAxisRegEx, err := regexp.Compile(`^\s*unset\s+([xy]{0,1})zeroaxis\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setStyleLineRegEx, err := regexp.Compile(`^\s*set\s+style\s+line\s+(\d+)(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setLabelRegEx, err := regexp.Compile(`^\s*set\s+label(\s+(\d+)){0,1}\s+"([^"]*)"(.*)$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setArrowRegEx, err := regexp.Compile(`^\s*set\s+arrow(\s+(\d+)){0,1}\s+(.*\S)\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setObjectRegEx, err := regexp.Compile(`^\s*set\s+object(\s+(\d+)){0,1}\s+(rect|rectangle|circle|ellipse|polygon)(\s+(.*\S)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	unsetAnnotationRegEx, err := regexp.Compile(`^\s*unset\s+(label|arrow|object)(\s+(\d+)){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setParametricRegEx, err := regexp.Compile(`^\s*(set|unset)\s+parametric\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setPolarRegEx, err := regexp.Compile(`^\s*(set|unset)\s+polar\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setAnglesRegEx, err := regexp.Compile(`^\s*set\s+angles\s+(\S+)\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setTRangeRegEx, err := regexp.Compile(`^\s*set\s+trange\s+\[\s*([-+]{0,1}[0-9.]+)\s*:\s*([-+]{0,1}[0-9.]+)\s*\]\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setViewRegEx, err := regexp.Compile(`^\s*set\s+view\s+(map|([0-9.]+)\s*,\s*([0-9.]+)(\s*,\s*([0-9.]+)){0,1})\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setHidden3dRegEx, err := regexp.Compile(`^\s*(set|unset)\s+hidden3d\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	setMultiplotRegEx, err := regexp.Compile(`^\s*set\s+multiplot(\s+layout\s+(\d+)\s*,\s*(\d+)){0,1}(\s+title\s+"([^"]*)"){0,1}\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	unsetMultiplotRegEx, err := regexp.Compile(`^\s*unset\s+multiplot\s*$`)
+	if err != nil {
+		return nil, err
+	}
+
+	//	set and unset commands finish a previous plot command
+	setCommandRegEx, err := regexp.Compile(`^\s*(set|unset)\s`)
+	if err != nil {
+		return nil, err
+	}
+
+	plotCommandRegEx, err := regexp.Compile(`^\s*(s{0,1}plot)\s*`)
 	if err != nil {
 		return nil, err
 	}
@@ -83,12 +280,22 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 		return nil, err
 	}
 
-	dataFilePlotUsingRegEx, err := regexp.Compile(`^\s*using\s+(\d+):(\d+)\s*`)
+	dataFileMatrixRegEx, err := regexp.Compile(`^\s*matrix(\s+|$)`)
+	if err != nil {
+		return nil, err
+	}
+
+	dataFilePlotUsingRegEx, err := regexp.Compile(`^\s*using\s+(\d+|\([-+]{0,1}[0-9.]+\))((:(\d+|\([-+]{0,1}[0-9.]+\)))*)(:xtic\((\d+)\)){0,1}\s*`)
+	if err != nil {
+		return nil, err
+	}
+
+	plotWithRegEx, err := regexp.Compile(`^\s*with\s+([a-z][a-z0-9]*)\s*`)
 	if err != nil {
 		return nil, err
 	}
 
-	plotWithRegEx, err := regexp.Compile(`^\s*with\s+([a-z]+)\s*`)
+	plotAxesRegEx, err := regexp.Compile(`^\s*axes\s+([a-z0-9]+)\s*`)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +305,18 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 		return nil, err
 	}
 
-	functionRegEx, err := regexp.Compile(`^\s*([^,]+)\s*[,]{0,1}`)
+	plotLineStyleRegEx, err := regexp.Compile(`^\s*(linecolor|lc|linewidth|lw|dashtype|dt|pointtype|pt|pointsize|ps|linestyle|ls)\s+\S+`)
+	if err != nil {
+		return nil, err
+	}
+
+	//	keywords that finish the description of a function in a plot command
+	plotFillStyleRegEx, err := regexp.Compile(`^\s*(fillstyle|fs)\s`)
+	if err != nil {
+		return nil, err
+	}
+
+	clauseKeywordRegEx, err := regexp.Compile(`^(with|title|using|axes|linecolor|lc|linewidth|lw|dashtype|dt|pointtype|pt|pointsize|ps|linestyle|ls|fillstyle|fs)\s`)
 	if err != nil {
 		return nil, err
 	}
@@ -113,23 +331,262 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 		plot = &Plot_2D{
 			Set_points: make([]Set_points_2d, 0),
 			Function:   make([]Function_2d, 0),
+			Histogram:  Histogram_style{Gap: DEFAULT_HISTOGRAM_GAP},
 		}
 
 		line      string
 		plotScope bool
+
+		multiplot         *Multiplot
+		finishedMultiplot *Multiplot
+
+		//	the settings and surfaces of splot commands
+		surface     = &Plot_3D{}
+		splotScope  bool
+		plotCommand bool
 	)
 
+	//	the z axis is used by splot commands
+	axisByName := func(axisName string) *Axis {
+		if axisName == "z" {
+			return &surface.Z_axis
+		}
+
+		return getAxis(plot, axisName)
+	}
+
 	var (
 		min_x        string = "-10"
 		max_x        string = "+10"
+		min_y        string = "-10"
+		max_y        string = "+10"
 		function     string
+		functionY    string
+		expectY      bool
+		min_t        string = strconv.Itoa(DEFAULT_MIN_T)
+		max_t        string = strconv.Itoa(DEFAULT_MAX_T)
+		parametric   bool
 		dataFileName string
-		x_column     string = "1"
-		y_column     string = "2"
+		matrix       bool
+		usingColumns []string
+		xticColumn   string
 		style        string = DEFAULT_STYLE
 		title        string
+		axes         uint8
+		lineStyle    Line_style
+		fillStyle    Fill_style
+		filledCurves Filled_curves
+		whiskerBars  float64
+		vectorStyle  Vector_style
+		lineStyles   = make(map[uint8]Line_style)
+		ticLabels    []Tic_label
+
+		plotFunction bool
+		plotDataFile bool
 	)
 
+	//	add the function or data file described by the current clause of a plot command
+	addPlotClause := func() error {
+		if (len(dataFileName) > 0 && (len(function) > 0 || plotFunction)) || (len(function) > 0 && plotDataFile) {
+			return errors.New("function and data file must be described separate in plot command")
+		}
+
+		//	the clauses of a splot command are added to the surfaces
+		if splotScope && len(dataFileName) > 0 {
+			auxSetPoints, err := newSetPoints3D(dataFileName, usingColumns, matrix, style, title)
+			if err != nil {
+				return err
+			}
+			auxSetPoints.Line_style = lineStyle
+
+			surface.Set_points = append(surface.Set_points, *auxSetPoints)
+			surface.Set_points[len(surface.Set_points)-1].order = uint8(len(surface.Set_points) + len(surface.Function))
+			plotDataFile = true
+			dataFileName = ""
+		}
+		if splotScope && len(function) > 0 {
+			auxFunction, err := newFunction3D(function, min_x, max_x, min_y, max_y, style, title)
+			if err != nil {
+				return err
+			}
+			auxFunction.Line_style = lineStyle
+
+			surface.Function = append(surface.Function, *auxFunction)
+			surface.Function[len(surface.Function)-1].order = uint8(len(surface.Set_points) + len(surface.Function))
+			plotFunction = true
+			function = ""
+		}
+
+		//	the values of a uniform matrix are placed at the column and row of each one
+		if len(dataFileName) > 0 && matrix {
+			if len(usingColumns) > 0 {
+				return errors.New("using option is not supported for matrix data: " + dataFileName)
+			}
+
+			auxSetPoints, err := newMatrixPoints2D(dataFileName, style, title)
+			if err != nil {
+				return err
+			}
+			auxSetPoints.Line_style = lineStyle
+			auxSetPoints.Fill = fillStyle
+			auxSetPoints.Axes = axes
+
+			plot.Set_points = append(plot.Set_points, *auxSetPoints)
+			plot.Set_points[len(plot.Set_points)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
+			plotDataFile = true
+		} else if len(dataFileName) > 0 {
+			//	a single column, as well as the columns of histograms, start with the y values and the line number is the x
+			columns := usingColumns
+			if len(columns) == 0 {
+				columns = []string{"1", "2"}
+			}
+
+			//	the last columns give the size and the colour of each point when they are variable
+			variableCount := lineStyle.variableColumns()
+			if len(columns) <= variableCount {
+				return errors.New("missing columns for variable size or colour: " + strings.Join(columns, ":"))
+			}
+			variableColumns := columns[len(columns)-variableCount:]
+			columns = columns[:len(columns)-variableCount]
+
+			boxplot := style == "boxplot" || style == "violin"
+
+			if len(columns) == 1 || style == "histograms" {
+				if len(title) == 0 {
+					title = dataFileName + " u " + strings.Join(columns, ":")
+				}
+
+				//	the sample of a single column box-and-whisker plot is placed at x = 1
+				if boxplot {
+					columns = append([]string{"(1)"}, columns...)
+				} else {
+					columns = append([]string{"0"}, columns...)
+				}
+			}
+
+			//	the fourth column of box-and-whisker plots is a factor that splits the sample
+			var factorColumn string
+
+			if boxplot && len(columns) == 4 {
+				factorColumn = columns[3]
+				columns = columns[:3]
+			}
+
+			auxSetPoints, err := newSetPoints2D(dataFileName, columns[0], columns[1], style, title, columns[2:]...)
+			if err != nil {
+				return err
+			}
+
+			err = addTicLabels(plot, auxSetPoints, dataFileName, xticColumn)
+			if err != nil {
+				return err
+			}
+
+			if len(factorColumn) > 0 {
+				err = addFactorLevels(plot, auxSetPoints, dataFileName, factorColumn)
+				if err != nil {
+					return err
+				}
+			}
+			auxSetPoints.Line_style = lineStyle
+
+			err = addVariableColumns(auxSetPoints, dataFileName, variableColumns)
+			if err != nil {
+				return err
+			}
+			auxSetPoints.Fill = fillStyle
+			auxSetPoints.Filled_curves = filledCurves
+			auxSetPoints.Whisker_bars = whiskerBars
+			auxSetPoints.Vector = vectorStyle
+			auxSetPoints.Axes = axes
+
+			plot.Set_points = append(plot.Set_points, *auxSetPoints)
+			plot.Set_points[len(plot.Set_points)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
+			plotDataFile = true
+		}
+
+		if len(function) > 0 {
+			var auxFunction *Function_2d
+
+			fmt.Printf("[debug] new function: %s\n", function)
+			if style == "contours" {
+				if parametric || plot.Polar {
+					return errors.New("contours require a function of x and y: " + function)
+				}
+
+				auxFunction, err = newContourFunction2D(function, min_x, max_x, min_y, max_y, style, title)
+			} else if strings.Contains(function, "=") {
+				//	the curves of relations f(x,y) = g(x,y) are traced in the intervals of x and y
+				if parametric || plot.Polar {
+					return errors.New("implicit relations require a function of x and y: " + function)
+				}
+
+				auxFunction, err = newContourFunction2D(function, min_x, max_x, min_y, max_y, style, title)
+				if err == nil {
+					auxFunction.Implicit = true
+				}
+			} else if parametric {
+				if len(functionY) == 0 {
+					return errors.New("parametric plot requires functions for x and y: " + function)
+				}
+
+				auxFunction, err = newParametricFunction2D(function, functionY, min_t, max_t, style, title)
+			} else if plot.Polar {
+				auxFunction, err = newPolarFunction2D(function, min_t, max_t, style, title)
+			} else {
+				auxFunction, err = newFunction2D(function, min_x, max_x, style, title)
+			}
+			if err != nil {
+				return err
+			}
+			auxFunction.Line_style = lineStyle
+			auxFunction.Fill = fillStyle
+			auxFunction.Filled_curves = filledCurves
+			auxFunction.Axes = axes
+
+			plot.Function = append(plot.Function, *auxFunction)
+			plot.Function[len(plot.Function)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
+			plotFunction = true
+		}
+
+		//	erase the clause as it was used already
+		function = ""
+		functionY = ""
+		expectY = false
+		dataFileName = ""
+		matrix = false
+		usingColumns = nil
+		xticColumn = ""
+		style = DEFAULT_STYLE
+		title = ""
+		axes = AXES_X1Y1
+		lineStyle = Line_style{}
+		fillStyle = Fill_style{}
+		filledCurves = Filled_curves{}
+		whiskerBars = 0
+		vectorStyle = Vector_style{}
+
+		return nil
+	}
+
+	//	add the last clause of a plot command, which is a panel in multiplot mode
+	finishPlotCommand := func() error {
+		err := addPlotClause()
+		if err != nil {
+			return err
+		}
+		plotScope = false
+
+		//	the next panel starts with the settings of the previous one, but the labels from data files
+		if multiplot != nil {
+			multiplot.Panels = append(multiplot.Panels, plot)
+			plot = plot.nextPanel()
+			plot.X_axis.Tic_labels = append([]Tic_label(nil), ticLabels...)
+		}
+
+		return nil
+	}
+
 	for {
 		bufLine, isPrefix, err := reader.ReadLine()
 		if err != nil {
@@ -142,15 +599,40 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 			//	parse the line using all regex for commands outside plot command scope
 			var commandFound bool
 
-			match := setXLabelRegEx.FindAllStringSubmatch(line, -1)
+			if plotScope && setCommandRegEx.MatchString(line) {
+				err = finishPlotCommand()
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			match := setAxisLabelRegEx.FindAllStringSubmatch(line, -1)
 			if len(match) == 1 {
-				plot.X_label = match[0][1]
+				switch match[0][1] {
+				case "x":
+					plot.X_label = match[0][2]
+
+				case "y":
+					plot.Y_label = match[0][2]
+
+				case "x2":
+					plot.X2_label = match[0][2]
+
+				case "y2":
+					plot.Y2_label = match[0][2]
+
+				case "z":
+					surface.Z_label = match[0][2]
+				}
 				commandFound = true
 			}
 
-			match = setYLabelRegEx.FindAllStringSubmatch(line, -1)
+			match = setRangeRegEx.FindAllStringSubmatch(line, -1)
 			if len(match) == 1 {
-				plot.Y_label = match[0][1]
+				err = parseRange(axisByName(match[0][1]), match[0][2], match[0][3])
+				if err != nil {
+					return nil, err
+				}
 				commandFound = true
 			}
 
@@ -162,6 +644,12 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 				if !found {
 					return nil, errors.New("invalid terminal type: " + match[0][1])
 				}
+
+				terminalOptions, err := parseTerminalOptions(plot.Terminal, match[0][2])
+				if err != nil {
+					return nil, err
+				}
+				plot.Terminal_options = *terminalOptions
 				commandFound = true
 			}
 
@@ -171,65 +659,419 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 				commandFound = true
 			}
 
-			//	if a command was found clean up current line
-			if commandFound {
-				if plotScope && len(dataFileName) > 0 && len(function) > 0 {
-					return nil, errors.New("function and data file must be described separate in plot command")
-				}
+			match = setLogScaleRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				var base float64 = DEFAULT_LOG_BASE
 
-				//	if previously parsed a plot command whose data file was not loaded yet, it's the time for it
-				if plotScope && len(dataFileName) > 0 {
-					auxSetPoints, err := newSetPoints2D(dataFileName, x_column, y_column, style, title)
-					if err != nil {
-						return nil, err
+				if len(match[0][4]) > 0 {
+					base, err = strconv.ParseFloat(match[0][4], 64)
+					if err != nil || base <= 1 {
+						return nil, errors.New("logscale base expected to be a number greater than 1: " + match[0][4])
 					}
+				}
 
-					//	erase file name as it was used already
-					dataFileName = ""
-					x_column = "1"
-					y_column = "2"
-					style = DEFAULT_STYLE
-					title = ""
+				err = setLogScale(plot, match[0][2], true, base)
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
 
-					plot.Set_points = append(plot.Set_points, *auxSetPoints)
-					plot.Set_points[len(plot.Set_points)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
+			match = unsetLogScaleRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				err = setLogScale(plot, match[0][2], false, 0)
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
 
-					plotScope = false
+			match = setTicsRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				err = parseTics(axisByName(match[0][1]), match[0][3])
+				if err != nil {
+					return nil, err
 				}
+				commandFound = true
+			}
 
-				//	if previously parsed a plot command whose function was not loaded yet, it's the time for it
-				if plotScope && len(function) > 0 {
-					fmt.Printf("[debug] [1] new function: %s\n", function)
-					auxFunction, err := newFunction2D(function, min_x, max_x, style, title)
-					if err != nil {
-						return nil, err
+			match = setMinorTicsRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				axis := axisByName(match[0][1])
+
+				axis.Minor_tics = MINOR_TICS_AUTO
+				if len(match[0][3]) > 0 {
+					axis.Minor_tics, _ = strconv.Atoi(match[0][3])
+					if axis.Minor_tics < 1 {
+						return nil, errors.New("number of minor tics intervals expected to be positive: " + match[0][3])
 					}
+				}
+				commandFound = true
+			}
 
-					//	erase function as it was used already
-					function = ""
-					style = DEFAULT_STYLE
-					title = ""
+			match = unsetMinorTicsRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				axisByName(match[0][1]).Minor_tics = MINOR_TICS_OFF
+				commandFound = true
+			}
 
-					plot.Function = append(plot.Function, *auxFunction)
-					plot.Function[len(plot.Function)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
+			match = setFormatRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				axes := match[0][2]
+				if len(axes) == 0 {
+					axes = "xy"
+				}
 
-					plotScope = false
+				//	validate the format before using it
+				_, err = formatTick(match[0][4], 1)
+				if err != nil {
+					return nil, err
 				}
 
-				line = ""
+				axisName := axisNames(axes)
+				if axisName == nil {
+					return nil, errors.New("invalid format axes: " + axes)
+				}
+
+				for _, name := range axisName {
+					getAxis(plot, name).Format = match[0][4]
+				}
+				commandFound = true
+			}
+
+			match = setGridRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				grid, err := parseGrid(match[0][2], lineStyles)
+				if err != nil {
+					return nil, err
+				}
+				plot.Grid = *grid
+				commandFound = true
+			}
+
+			match = unsetGridRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				plot.Grid = Grid{}
+				commandFound = true
+			}
+
+			match = setStyleHistogramRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				histogram, err := parseHistogramStyle(match[0][2], match[0][4])
+				if err != nil {
+					return nil, err
+				}
+				plot.Histogram = *histogram
+				commandFound = true
+			}
+
+			match = setStyleCandlesticksRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				plot.Financial = Financial_style{}
+
+				err = parseFinancialStyle(&plot.Financial, match[0][1])
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
+
+			match = setStyleBoxplotRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				boxplot, err := parseBoxplotStyle(match[0][2], match[0][4])
+				if err != nil {
+					return nil, err
+				}
+				plot.Boxplot = *boxplot
+				commandFound = true
+			}
+
+			match = setPaletteRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				palette, err := parsePalette(match[0][2])
+				if err != nil {
+					return nil, err
+				}
+				plot.Palette = *palette
+				commandFound = true
+			}
+
+			match = setColourBoxRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				plot.No_colour_box = match[0][1] == "unset"
+				commandFound = true
+			}
+
+			match = setIsoSamplesRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				plot.Iso_samples_x, _ = strconv.Atoi(match[0][1])
+				plot.Iso_samples_y = plot.Iso_samples_x
+				if len(match[0][3]) > 0 {
+					plot.Iso_samples_y, _ = strconv.Atoi(match[0][3])
+				}
+
+				if plot.Iso_samples_x < 2 || plot.Iso_samples_y < 2 {
+					return nil, errors.New("isosamples expected to be at least 2: " + strings.TrimSpace(line))
+				}
+				commandFound = true
+			}
+
+			//	gnuplot's second number of samples is not supported, as the surfaces are sampled in the isosamples grid
+			match = setSamplesRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				if len(match[0][3]) > 0 {
+					return nil, errors.New("a single number of samples expected: " + strings.TrimSpace(line))
+				}
+
+				plot.Samples, _ = strconv.Atoi(match[0][1])
+				if plot.Samples < 2 {
+					return nil, errors.New("samples expected to be at least 2: " + strings.TrimSpace(line))
+				}
+				commandFound = true
+			}
+
+			match = setContourParamRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				err = parseContourParam(&plot.Contour.Levels, match[0][2])
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
+
+			match = setContourLabelRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				plot.Contour.No_labels = match[0][1] == "unset"
+				commandFound = true
+			}
+
+			match = setBarsRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				size, err := parseBarSize(match[0][1], match[0][3])
+				if err != nil {
+					return nil, err
+				}
+				plot.Bar_size = &size
+				commandFound = true
+			}
+
+			match = setZeroAxisRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				style, err := parseLineStyle(match[0][3], lineStyles)
+				if err != nil {
+					return nil, errors.New("invalid zero axis line style: " + err.Error())
+				}
+
+				//	without an axis name, both zero axes are set
+				if match[0][1] != "y" {
+					xStyle := *style
+					plot.X_zero_axis = &xStyle
+				}
+				if match[0][1] != "x" {
+					yStyle := *style
+					plot.Y_zero_axis = &yStyle
+				}
+				commandFound = true
+			}
+
+			match = unsetZeroAxisRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				if match[0][1] != "y" {
+					plot.X_zero_axis = nil
+				}
+				if match[0][1] != "x" {
+					plot.Y_zero_axis = nil
+				}
+				commandFound = true
+			}
+
+			match = setStyleLineRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				index, err := strconv.ParseUint(match[0][1], 10, 8)
+				if err != nil || index == 0 {
+					return nil, errors.New("invalid line style index: " + match[0][1])
+				}
+
+				definedStyle, err := parseLineStyle(match[0][3], lineStyles)
+				if err != nil {
+					return nil, err
+				}
+				lineStyles[uint8(index)] = *definedStyle
+				commandFound = true
+			}
+
+			match = setLabelRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				annotation := &Annotation{
+					Type: ANNOTATION_LABEL,
+					Text: match[0][3],
+				}
+
+				err = parseAnnotation(plot, annotation, match[0][2], match[0][4], lineStyles)
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
+
+			match = setArrowRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				annotation := &Annotation{
+					Type: ANNOTATION_ARROW,
+				}
+
+				err = parseAnnotation(plot, annotation, match[0][2], match[0][3], lineStyles)
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
+
+			match = setObjectRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				objectType := match[0][3]
+				if objectType == "rectangle" {
+					objectType = "rect"
+				}
+
+				annotation := &Annotation{
+					Type: Annotation_type[objectType],
+				}
+
+				err = parseAnnotation(plot, annotation, match[0][2], match[0][5], lineStyles)
+				if err != nil {
+					return nil, err
+				}
+				commandFound = true
+			}
+
+			match = unsetAnnotationRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				unsetAnnotation(plot, match[0][1], match[0][3])
+				commandFound = true
+			}
+
+			match = setParametricRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				parametric = match[0][1] == "set"
+				commandFound = true
+			}
+
+			match = setPolarRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				plot.Polar = match[0][1] == "set"
+				commandFound = true
+			}
+
+			match = setAnglesRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				switch match[0][1] {
+				case "degrees":
+					plot.Angles = expression.DEGREES
+
+				case "radians":
+					plot.Angles = expression.RADIANS
+
+				default:
+					return nil, errors.New("invalid angles unit: " + match[0][1])
+				}
+				commandFound = true
+			}
+
+			match = setTRangeRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				min_t = match[0][1]
+				max_t = match[0][2]
+				commandFound = true
+			}
+
+			match = setViewRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				view, err := parseView(match[0][1], match[0][2], match[0][3], match[0][5])
+				if err != nil {
+					return nil, err
+				}
+				surface.View = view
+				commandFound = true
+			}
+
+			match = setHidden3dRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				surface.Hidden3d = match[0][1] == "set"
+				commandFound = true
+			}
+
+			match = setMultiplotRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				if multiplot != nil || finishedMultiplot != nil {
+					return nil, errors.New("only one multiplot is allowed in a plot file")
+				}
+				if len(plot.Set_points) > 0 || len(plot.Function) > 0 {
+					return nil, errors.New("plot command before set multiplot")
+				}
+
+				multiplot = &Multiplot{
+					Title:            match[0][5],
+					Width:            plot.Width,
+					Height:           plot.Height,
+					Terminal:         plot.Terminal,
+					Terminal_options: plot.Terminal_options,
+					output:           plot.output,
+				}
+				if len(match[0][1]) > 0 {
+					multiplot.Rows, _ = strconv.Atoi(match[0][2])
+					multiplot.Columns, _ = strconv.Atoi(match[0][3])
+
+					if multiplot.Rows == 0 || multiplot.Columns == 0 {
+						return nil, errors.New("invalid multiplot layout: " + match[0][2] + "," + match[0][3])
+					}
+				}
+				commandFound = true
+			}
+
+			match = unsetMultiplotRegEx.FindAllStringSubmatch(line, -1)
+			if len(match) == 1 {
+				finishedMultiplot = multiplot
+				multiplot = nil
+				commandFound = true
+			}
+
+			//	if a command was found clean up current line
+			if commandFound {
+				line = ""
 				continue
 			}
 
 			//	if it's not a configuration command, try to parse plot command options
 			for {
-				if len(line) == 0 {
+				if len(strings.TrimSpace(line)) == 0 {
+					line = ""
 					break
 				}
 				fmt.Printf("[debug] not config line: %s\n", line)
 
 				match = plotCommandRegEx.FindAllStringSubmatch(line, -1)
 				if len(match) == 1 {
+					//	a new plot command finishes the previous one
+					if plotScope {
+						err = finishPlotCommand()
+						if err != nil {
+							return nil, err
+						}
+					}
+					//	surfaces are plotted by splot commands, which are not supported in multiplot mode
+					splotScope = match[0][1] == "splot"
+					if splotScope && multiplot != nil {
+						return nil, errors.New("splot command is not supported in multiplot mode")
+					}
+					if !splotScope {
+						plotCommand = true
+					}
+
 					plotScope = true
+					plotFunction = false
+					plotDataFile = false
+					ticLabels = plot.X_axis.Tic_labels
 
 					line = line[len(match[0][0]):]
 					continue
@@ -240,10 +1082,25 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 					if !plotScope {
 						return nil, errors.New("range specification without a plot command: " + match[0][0])
 					}
-					min_x = match[0][1]
-					max_x = match[0][2]
 
+					//	in parametric and polar modes, the range is for the parameter t
+					if parametric || plot.Polar {
+						min_t = match[0][1]
+						max_t = match[0][2]
+					} else {
+						min_x = match[0][1]
+						max_x = match[0][2]
+					}
 					line = line[len(match[0][0]):]
+
+					//	a second range is the interval of y, used by functions of x and y
+					match = rangeRegEx.FindAllStringSubmatch(line, -1)
+					if len(match) == 1 {
+						min_y = match[0][1]
+						max_y = match[0][2]
+
+						line = line[len(match[0][0]):]
+					}
 					continue
 				}
 
@@ -258,13 +1115,24 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 					continue
 				}
 
+				match = dataFileMatrixRegEx.FindAllStringSubmatch(line, -1)
+				if len(match) == 1 && len(dataFileName) > 0 {
+					matrix = true
+
+					line = line[len(match[0][0]):]
+					continue
+				}
+
 				match = dataFilePlotUsingRegEx.FindAllStringSubmatch(line, -1)
 				if len(match) == 1 {
 					if !plotScope {
 						return nil, errors.New("'using' option without a plot command: " + match[0][0])
 					}
-					x_column = match[0][1]
-					y_column = match[0][2]
+					usingColumns = []string{match[0][1]}
+					if len(match[0][2]) > 0 {
+						usingColumns = append(usingColumns, strings.Split(match[0][2][1:], ":")...)
+					}
+					xticColumn = match[0][6]
 
 					line = line[len(match[0][0]):]
 					continue
@@ -276,6 +1144,67 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 						return nil, errors.New("'with' option without a plot command: " + match[0][0])
 					}
 					style = match[0][1]
+					line = line[len(match[0][0]):]
+
+					//	the filledcurves style is followed by the reference of the area
+					if style == "filledcurves" {
+						length, err := parseFilledCurves(&filledCurves, line)
+						if err != nil {
+							return nil, err
+						}
+						line = line[length:]
+					}
+
+					//	the candlesticks style is followed by the width of the whisker bars
+					if style == "candlesticks" {
+						length, err := parseWhiskerBars(&whiskerBars, line)
+						if err != nil {
+							return nil, err
+						}
+						line = line[length:]
+					}
+
+					//	the vectors style is followed by the heads of the arrows
+					if style == "vectors" {
+						length, err := parseVectorStyle(&vectorStyle, line)
+						if err != nil {
+							return nil, err
+						}
+						line = line[length:]
+					}
+					continue
+				}
+
+				match = plotFillStyleRegEx.FindAllStringSubmatch(line, -1)
+				if len(match) == 1 {
+					if !plotScope {
+						return nil, errors.New("'" + match[0][1] + "' option without a plot command: " + match[0][0])
+					}
+
+					length, err := parseFillStyle(&fillStyle, line)
+					if err != nil {
+						return nil, err
+					}
+					if length == 0 {
+						return nil, errors.New("invalid fill style: " + line)
+					}
+
+					line = line[length:]
+					continue
+				}
+
+				match = plotAxesRegEx.FindAllStringSubmatch(line, -1)
+				if len(match) == 1 {
+					if !plotScope {
+						return nil, errors.New("'axes' option without a plot command: " + match[0][0])
+					}
+
+					var found bool
+
+					axes, found = Axes_pair[match[0][1]]
+					if !found {
+						return nil, errors.New("invalid axes: " + match[0][1])
+					}
 
 					line = line[len(match[0][0]):]
 					continue
@@ -288,116 +1217,1048 @@ func LoadPlotFile(reader *bufio.Reader) (Plot, error) {
 					}
 					title = match[0][1]
 
-					line = line[len(match[0][0]):]
-					continue
-				}
+					line = line[len(match[0][0]):]
+					continue
+				}
+
+				match = plotLineStyleRegEx.FindAllStringSubmatch(line, -1)
+				if len(match) == 1 {
+					if !plotScope {
+						return nil, errors.New("'" + match[0][1] + "' option without a plot command: " + match[0][0])
+					}
+
+					length, err := parseLineStyleOption(&lineStyle, line, lineStyles)
+					if err != nil {
+						return nil, err
+					}
+					if length == 0 {
+						return nil, errors.New("invalid line style option: " + match[0][0])
+					}
+
+					line = line[length:]
+					continue
+				}
+
+				//	when a comma is found in the scope of a plot command, add the function or data file points
+				match = commaSeparatorRegEx.FindAllStringSubmatch(line, -1)
+				if len(match) == 1 {
+					if !plotScope {
+						return nil, errors.New("unexpected syntax: " + match[0][1])
+					}
+
+					//	in parametric mode, the comma after the x function separates it from the y one
+					if parametric && len(function) > 0 && len(functionY) == 0 && !expectY {
+						expectY = true
+						line = line[len(match[0][0]):]
+						continue
+					}
+
+					err = addPlotClause()
+					if err != nil {
+						return nil, err
+					}
+
+					line = line[len(match[0][0]):]
+					continue
+				}
+
+				//	the rest of the clause is the function
+				length := functionLength(line, clauseKeywordRegEx)
+				if length == 0 {
+					return nil, errors.New("unexpected syntax: " + line)
+				}
+				if !plotScope {
+					return nil, errors.New("function specification without a plot command: " + strings.TrimSpace(line[:length]))
+				}
+
+				//	the y function of a parametric clause follows the x one
+				if expectY {
+					functionY = strings.TrimSpace(line[:length])
+					expectY = false
+					line = line[length:]
+					continue
+				}
+
+				//	if function was found before, add it
+				if len(function) > 0 {
+					err = addPlotClause()
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				function = strings.TrimSpace(line[:length])
+				fmt.Printf("[debug] function found: %s\n", function)
+
+				line = line[length:]
+			}
+		}
+	}
+
+	//	when plot file parsing finishes, if a plot command whose last clause was not added yet, it's the time for it
+	if plotScope {
+		err = finishPlotCommand()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//	the surfaces of splot commands are the output of the plot file, with the settings shared with 2D plots
+	if len(surface.Set_points) > 0 || len(surface.Function) > 0 {
+		if plotCommand || finishedMultiplot != nil {
+			return nil, errors.New("plot and splot commands in the same plot file")
+		}
+
+		surface.X_label = plot.X_label
+		surface.Y_label = plot.Y_label
+		surface.X_axis = plot.X_axis
+		surface.Y_axis = plot.Y_axis
+		surface.Palette = plot.Palette
+		surface.No_colour_box = plot.No_colour_box
+		surface.Iso_samples_x = plot.Iso_samples_x
+		surface.Iso_samples_y = plot.Iso_samples_y
+		surface.Angles = plot.Angles
+		surface.Width = plot.Width
+		surface.Height = plot.Height
+		surface.Terminal = plot.Terminal
+		surface.Terminal_options = plot.Terminal_options
+		surface.output = plot.output
+
+		return surface, nil
+	}
+
+	//	a multiplot is the output of the plot file, even when not unset
+	if multiplot != nil {
+		finishedMultiplot = multiplot
+	}
+	if finishedMultiplot != nil {
+		if len(plot.Set_points) > 0 || len(plot.Function) > 0 {
+			return nil, errors.New("plot command after unset multiplot")
+		}
+
+		return finishedMultiplot, nil
+	}
+
+	return plot, nil
+}
+
+//	annotationKind return the kind of an annotation, as labels, arrows and objects have their own tags
+func annotationKind(annotationType uint8) string {
+
+	switch annotationType {
+	case ANNOTATION_LABEL:
+		return "label"
+
+	case ANNOTATION_ARROW:
+		return "arrow"
+	}
+
+	return "object"
+}
+
+//	parseAnnotation parse the options of a set label, arrow or object command and add the annotation to the plot
+func parseAnnotation(plot *Plot_2D, annotation *Annotation, tag string, options string, definedStyles map[uint8]Line_style) error {
+
+	err := parseAnnotationOptions(annotation, options, definedStyles)
+	if err != nil {
+		return err
+	}
+
+	err = annotation.Validate()
+	if err != nil {
+		return errors.New(err.Error() + ": " + strings.TrimSpace(options))
+	}
+
+	//	when not informed, the tag is the next one for the kind of annotation
+	kind := annotationKind(annotation.Type)
+
+	if len(tag) > 0 {
+		annotation.Tag, _ = strconv.Atoi(tag)
+	} else {
+		annotation.Tag = 1
+		for _, item := range plot.Annotations {
+			if annotationKind(item.Type) == kind && item.Tag >= annotation.Tag {
+				annotation.Tag = item.Tag + 1
+			}
+		}
+	}
+
+	//	an annotation replaces the previous one with the same tag
+	for i, item := range plot.Annotations {
+		if annotationKind(item.Type) == kind && item.Tag == annotation.Tag {
+			plot.Annotations[i] = *annotation
+			return nil
+		}
+	}
+	plot.Annotations = append(plot.Annotations, *annotation)
+
+	return nil
+}
+
+//	unsetAnnotation remove all annotations of a kind or the one with a given tag
+func unsetAnnotation(plot *Plot_2D, kind string, tag string) {
+
+	annotations := make([]Annotation, 0, len(plot.Annotations))
+
+	for _, item := range plot.Annotations {
+		if annotationKind(item.Type) == kind && (len(tag) == 0 || strconv.Itoa(item.Tag) == tag) {
+			continue
+		}
+		annotations = append(annotations, item)
+	}
+
+	plot.Annotations = annotations
+}
+
+//	parseAnnotationOptions parse the options of an annotation: positions, text attributes, arrow heads and fill
+func parseAnnotationOptions(annotation *Annotation, options string, definedStyles map[uint8]Line_style) error {
+
+	positionOptionRegEx, err := regexp.Compile(`^\s*(at|from|to|size)\s+`)
+	if err != nil {
+		return err
+	}
+
+	justificationOptionRegEx, err := regexp.Compile(`^\s*(left|center|right)(\s+|$)`)
+	if err != nil {
+		return err
+	}
+
+	rotateOptionRegEx, err := regexp.Compile(`^\s*(rotate\s+by|angle)\s+([-+]{0,1}[0-9.]+)\s*`)
+	if err != nil {
+		return err
+	}
+
+	fontOptionRegEx, err := regexp.Compile(`^\s*font\s+"([^",]*)(,(\d+)){0,1}"\s*`)
+	if err != nil {
+		return err
+	}
+
+	colourOptionRegEx, err := regexp.Compile(`^\s*(textcolor|tc|fillcolor|fc)\s+(rgb\s+){0,1}"([^"]+)"\s*`)
+	if err != nil {
+		return err
+	}
+
+	keywordOptionRegEx, err := regexp.Compile(`^\s*(heads|head|nohead|backhead|front|back|noborder|norotate|fillstyle\s+empty|fs\s+empty)(\s+|$)`)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if len(strings.TrimSpace(options)) == 0 {
+			break
+		}
+
+		match := positionOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			options = options[len(match[0][0]):]
+
+			//	only sizes can have a single component
+			position, length, err := parseCoordinate(options, match[0][1] == "size")
+			if err != nil {
+				return err
+			}
+
+			switch match[0][1] {
+			case "at", "from":
+				annotation.Position = []Coordinate{*position}
+
+			case "to":
+				annotation.Position = append(annotation.Position, *position)
+
+			case "size":
+				annotation.Size = *position
+			}
+
+			options = options[length:]
+			continue
+		}
+
+		match = justificationOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			annotation.Justification = Justification[match[0][1]]
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		match = rotateOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			annotation.Angle, err = strconv.ParseFloat(match[0][2], 64)
+			if err != nil {
+				return errors.New("rotation angle expected to be numeric: " + match[0][2])
+			}
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		match = fontOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			annotation.Font_family = match[0][1]
+
+			if len(match[0][3]) > 0 {
+				fontSize, err := strconv.ParseUint(match[0][3], 10, 8)
+				if err != nil || fontSize == 0 {
+					return errors.New("invalid font size: " + match[0][3])
+				}
+				annotation.Font_size = uint8(fontSize)
+			}
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		match = colourOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			colour, err := ParseColour(match[0][3])
+			if err != nil {
+				return err
+			}
+
+			if match[0][1] == "fillcolor" || match[0][1] == "fc" {
+				annotation.Fill_colour = &colour
+			} else {
+				annotation.Line_style.Colour = &colour
+			}
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		match = keywordOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			switch strings.Join(strings.Fields(match[0][1]), " ") {
+			case "head", "nohead", "heads", "backhead":
+				annotation.Head = Arrow_head[match[0][1]]
+
+			case "front":
+				annotation.Layer = LAYER_FRONT
+
+			case "back":
+				annotation.Layer = LAYER_BACK
+
+			case "noborder":
+				annotation.No_border = true
+
+			case "norotate":
+				annotation.Angle = 0
+
+			case "fillstyle empty", "fs empty":
+				annotation.Fill_colour = nil
+			}
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		length, err := parseLineStyleOption(&annotation.Line_style, options, definedStyles)
+		if err != nil {
+			return err
+		}
+		if length > 0 {
+			options = options[length:]
+			continue
+		}
+
+		return errors.New("invalid " + annotationKind(annotation.Type) + " option: " + strings.TrimSpace(options))
+	}
+
+	return nil
+}
+
+//	parseCoordinate parse a coordinate where each component can be preceded by it's coordinate system
+func parseCoordinate(text string, singleComponent bool) (*Coordinate, int, error) {
+
+	coordinateRegEx, err := regexp.Compile(`^\s*((first|second|graph|screen)\s+){0,1}([-+]{0,1}[0-9.]+([eE][-+]{0,1}[0-9]+){0,1})` +
+		`(\s*,\s*((first|second|graph|screen)\s+){0,1}([-+]{0,1}[0-9.]+([eE][-+]{0,1}[0-9]+){0,1})){0,1}\s*`)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	match := coordinateRegEx.FindAllStringSubmatch(text, -1)
+	if len(match) != 1 || (len(match[0][5]) == 0 && !singleComponent) {
+		return nil, 0, errors.New("invalid coordinate: " + strings.TrimSpace(text))
+	}
+
+	var position Coordinate
+
+	position.X, err = strconv.ParseFloat(match[0][3], 64)
+	if err != nil {
+		return nil, 0, errors.New("coordinate expected to be numeric: " + match[0][3])
+	}
+	position.X_system = Coordinate_system[match[0][2]]
+
+	//	the second component uses the coordinate system of the first one when it's not informed
+	position.Y = position.X
+	position.Y_system = position.X_system
+
+	if len(match[0][5]) > 0 {
+		position.Y, err = strconv.ParseFloat(match[0][8], 64)
+		if err != nil {
+			return nil, 0, errors.New("coordinate expected to be numeric: " + match[0][8])
+		}
+		if len(match[0][7]) > 0 {
+			position.Y_system = Coordinate_system[match[0][7]]
+		}
+	}
+
+	return &position, len(match[0][0]), nil
+}
+
+//	functionLength return the length of the function in the beginning of a plot clause (up to a comma or an option)
+func functionLength(line string, keywordRegEx *regexp.Regexp) int {
+
+	var depth int
+
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '(':
+			depth++
+
+		case ')':
+			depth--
+
+		case ',':
+			if depth == 0 {
+				return i
+			}
+
+		default:
+			//	an option keyword after a blank finishes the function
+			if depth == 0 && i > 0 && (line[i-1] == ' ' || line[i-1] == '\t') && keywordRegEx.MatchString(line[i:]) {
+				return i
+			}
+		}
+	}
+
+	return len(line)
+}
+
+//	getAxis get a plot axis from it's name
+func getAxis(plot *Plot_2D, axisName string) *Axis {
+
+	switch axisName {
+	case "x":
+		return &plot.X_axis
+
+	case "y":
+		return &plot.Y_axis
+
+	case "x2":
+		return &plot.X2_axis
+
+	case "y2":
+		return &plot.Y2_axis
+
+	case "cb":
+		return &plot.Cb_axis
+	}
+
+	return nil
+}
+
+//	axisNames split a list of axes like "xy" or "y2" into the names of each axis
+func axisNames(axes string) []string {
+
+	var names []string
+
+	for i := 0; i < len(axes); i++ {
+		if axes[i] != 'x' && axes[i] != 'y' {
+			return nil
+		}
+
+		//	secondary axes are followed by a 2
+		name := axes[i : i+1]
+		if i+1 < len(axes) && axes[i+1] == '2' {
+			name += "2"
+			i++
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+//	parseRange parse the limits of a set range command (empty or * limits are autoscaled)
+func parseRange(axis *Axis, min string, max string) error {
+
+	var limit [2]*float64
+
+	for i, value := range []string{min, max} {
+		if len(value) == 0 || value == "*" {
+			continue
+		}
+
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.New("axis range limit expected to be numeric: " + value)
+		}
+		limit[i] = &number
+	}
+
+	if limit[0] != nil && limit[1] != nil && *limit[0] >= *limit[1] {
+		return errors.New("axis range minimum expected to be less than maximum: " + min + ":" + max)
+	}
+	axis.Min = limit[0]
+	axis.Max = limit[1]
+
+	return nil
+}
+
+//	parseTics parse the options of a set tics command: [start,]incr[,end] or ("label" pos, ...)
+func parseTics(axis *Axis, options string) error {
+
+	ticLabelRegEx, err := regexp.Compile(`^\s*("([^"]*)"\s+){0,1}([-+]{0,1}[0-9.]+([eE][-+]{0,1}[0-9]+){0,1})\s*(,|$)`)
+	if err != nil {
+		return err
+	}
+
+	//	no options means automatic tics
+	axis.Tics = nil
+	axis.Tic_labels = nil
+
+	if len(options) == 0 {
+		return nil
+	}
+
+	//	parse a list of labelled tics
+	if options[0] == '(' {
+		if options[len(options)-1] != ')' {
+			return errors.New("tics list expected to be enclosed by parenthesis: " + options)
+		}
+		options = options[1 : len(options)-1]
+
+		axis.Tic_labels = make([]Tic_label, 0)
+
+		for {
+			if len(options) == 0 {
+				break
+			}
+
+			match := ticLabelRegEx.FindAllStringSubmatch(options, -1)
+			if len(match) != 1 {
+				return errors.New("invalid tics list: " + options)
+			}
+
+			value, err := strconv.ParseFloat(match[0][3], 64)
+			if err != nil {
+				return errors.New("tic position expected to be numeric: " + match[0][3])
+			}
+
+			axis.Tic_labels = append(axis.Tic_labels, Tic_label{
+				Label: match[0][2],
+				Value: value,
+			})
+
+			options = options[len(match[0][0]):]
+		}
+
+		return nil
+	}
+
+	//	parse a series of tics
+	var number []float64
+
+	for _, item := range strings.Split(options, ",") {
+		value, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+		if err != nil {
+			return errors.New("tics expected to be numeric: " + options)
+		}
+		number = append(number, value)
+	}
+
+	tics := &Tics_series{
+		Start: math.Inf(-1),
+		End:   math.Inf(1),
+	}
+
+	switch len(number) {
+	case 1:
+		tics.Increment = number[0]
+
+	case 2:
+		tics.Start = number[0]
+		tics.Increment = number[1]
+
+	case 3:
+		tics.Start = number[0]
+		tics.Increment = number[1]
+		tics.End = number[2]
+
+	default:
+		return errors.New("invalid tics series: " + options)
+	}
+
+	if tics.Increment <= 0 {
+		return errors.New("tics increment expected to be positive: " + options)
+	}
+	axis.Tics = tics
+
+	return nil
+}
+
+//	parseGrid parse the options of a set grid command: [xtics] [ytics] [mxtics] [mytics] [polar [angle]] [line style options]
+func parseGrid(options string, definedStyles map[uint8]Line_style) (*Grid, error) {
+
+	ticsOptionRegEx, err := regexp.Compile(`^\s*(m{0,1}[xy]tics)\s*`)
+	if err != nil {
+		return nil, err
+	}
+
+	polarOptionRegEx, err := regexp.Compile(`^\s*polar(\s+([0-9.]+)){0,1}\s*`)
+	if err != nil {
+		return nil, err
+	}
+
+	var grid Grid
+
+	for {
+		if len(options) == 0 {
+			break
+		}
+
+		match := ticsOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			switch match[0][1] {
+			case "xtics":
+				grid.X_tics = true
+
+			case "ytics":
+				grid.Y_tics = true
+
+			case "mxtics":
+				grid.X_minor_tics = true
+
+			case "mytics":
+				grid.Y_minor_tics = true
+			}
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		match = polarOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			grid.Polar = true
+			if len(match[0][2]) > 0 {
+				grid.Polar_step, err = strconv.ParseFloat(match[0][2], 64)
+				if err != nil || grid.Polar_step <= 0 {
+					return nil, errors.New("invalid polar grid angle: " + match[0][2])
+				}
+			}
+
+			options = options[len(match[0][0]):]
+			continue
+		}
+
+		length, err := parseLineStyleOption(&grid.Line_style, options, definedStyles)
+		if err != nil {
+			return nil, errors.New("invalid grid line style: " + err.Error())
+		}
+		if length > 0 {
+			options = options[length:]
+			continue
+		}
+
+		return nil, errors.New("invalid grid option: " + options)
+	}
+
+	//	when no tics are informed, the grid is drawn for the major tics
+	if !grid.enabled() {
+		grid.X_tics = true
+		grid.Y_tics = true
+	}
+
+	return &grid, nil
+}
+
+//	setLogScale set or unset the logarithmic scale for the axes of a plot (all axes when none is informed)
+func setLogScale(plot *Plot_2D, axes string, logScale bool, base float64) error {
+
+	if len(axes) == 0 {
+		axes = "xy"
+	}
+
+	axisName := axisNames(axes)
+	if axisName == nil {
+		return errors.New("invalid logscale axes: " + axes)
+	}
+
+	for _, name := range axisName {
+		axis := getAxis(plot, name)
+
+		axis.Log_scale = logScale
+		axis.Log_base = base
+	}
+
+	return nil
+}
+
+//	validExtraColumns check if a style accept the number of columns after x and y
+func validExtraColumns(style uint8, columns int) bool {
+
+	switch style {
+	case Y_ERROR_BARS, X_ERROR_BARS, Y_ERROR_LINES, BOX_ERROR_BARS:
+		return columns == 1 || columns == 2
+
+	case XY_ERROR_BARS:
+		return columns == 2 || columns == 4
+
+	case FILLED_CURVES:
+		return columns == 0 || columns == 1
+
+	case HISTOGRAMS:
+		return columns <= 2
+
+	case CANDLESTICKS, FINANCEBARS:
+		return columns == 3
+
+	case BOXPLOT, VIOLIN:
+		return columns <= 1
+
+	case VECTORS:
+		return columns == 2
+
+	case CIRCLES:
+		return columns <= 1
+
+	case IMAGE, CONTOURS:
+		return columns == 1
+	}
+
+	return columns == 0
+}
+
+//	parseUsingColumn parse a column of the using option, which is a number or a constant value between parenthesis
+func parseUsingColumn(column string) (uint8, *float64, error) {
+
+	if strings.HasPrefix(column, "(") && strings.HasSuffix(column, ")") {
+		value, err := strconv.ParseFloat(column[1:len(column)-1], 64)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return 0, &value, nil
+	}
+
+	num_column, err := strconv.Atoi(column)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return uint8(num_column), nil, nil
+}
+
+//	parseHistogramStyle parse the layout and gap of a set style histogram command
+func parseHistogramStyle(layout, gap string) (*Histogram_style, error) {
+
+	histogram := Histogram_style{Gap: DEFAULT_HISTOGRAM_GAP}
+
+	if len(layout) > 0 {
+		var found bool
+
+		histogram.Layout, found = Histogram_layout[layout]
+		if !found {
+			return nil, errors.New("invalid histogram layout: " + layout)
+		}
+	}
+
+	if len(gap) > 0 {
+		var err error
+
+		histogram.Gap, err = strconv.ParseFloat(gap, 64)
+		if err != nil {
+			return nil, errors.New("invalid histogram gap: " + gap)
+		}
+	}
+
+	return &histogram, nil
+}
+
+//	addTicLabels add the labels of the x axis for a set of points, from a column of the data file or from the
+//	title of a column stacked histogram
+func addTicLabels(plot *Plot_2D, set *Set_points_2d, dataFileName, xticColumn string) error {
+
+	if set.Style == HISTOGRAMS && plot.Histogram.Layout == HISTOGRAM_COLUMNSTACKED {
+		column := 0
+		for _, previous := range plot.Set_points {
+			if previous.Style == HISTOGRAMS {
+				column++
+			}
+		}
+		plot.X_axis.Tic_labels = append(plot.X_axis.Tic_labels, Tic_label{Label: set.Title, Value: float64(column)})
+
+		return nil
+	}
+
+	if len(xticColumn) == 0 {
+		return nil
+	}
+
+	num_column, err := strconv.Atoi(xticColumn)
+	if err != nil {
+		return errors.New("xtic column expected to be numeric: " + err.Error())
+	}
+
+	dataFile, err := os.Open(dataFileName)
+	if err != nil {
+		return errors.New("fail attempting to open Go-Plot data file: " + err.Error())
+	}
+	defer dataFile.Close()
+
+	label, err := LoadDataLabels(uint8(num_column), bufio.NewReader(dataFile))
+	if err != nil {
+		return errors.New("fail attempting to load Go-Plot data file: " + err.Error())
+	}
+
+	//	the labels of a row are added only once, when several sets use them
+	for i := range label {
+		found := false
+		for _, ticLabel := range plot.X_axis.Tic_labels {
+			if ticLabel.Value == set.Point[i].X {
+				found = true
+			}
+		}
+
+		if !found {
+			plot.X_axis.Tic_labels = append(plot.X_axis.Tic_labels, Tic_label{Label: label[i], Value: set.Point[i].X})
+		}
+	}
+
+	return nil
+}
+
+//	addFactorLevels split the samples of a box-and-whisker plot by the levels of a factor column, placing each level
+//	side by side from the x of the samples, with the level as the label of the x axis
+func addFactorLevels(plot *Plot_2D, set *Set_points_2d, dataFileName, factorColumn string) error {
+
+	num_column, err := strconv.Atoi(factorColumn)
+	if err != nil {
+		return errors.New("factor column expected to be numeric: " + err.Error())
+	}
+
+	dataFile, err := os.Open(dataFileName)
+	if err != nil {
+		return errors.New("fail attempting to open Go-Plot data file: " + err.Error())
+	}
+	defer dataFile.Close()
+
+	level, err := LoadDataLabels(uint8(num_column), bufio.NewReader(dataFile))
+	if err != nil {
+		return errors.New("fail attempting to load Go-Plot data file: " + err.Error())
+	}
+
+	index := make(map[string]int)
+
+	for i := range level {
+		if _, found := index[level[i]]; !found {
+			index[level[i]] = len(index)
+			plot.X_axis.Tic_labels = append(plot.X_axis.Tic_labels, Tic_label{Label: level[i], Value: set.Point[i].X + float64(index[level[i]])})
+		}
+		set.Point[i].X += float64(index[level[i]])
+	}
+
+	return nil
+}
+
+//	addVariableColumns load the columns giving the size and the colour of each point of a set, in this order
+func addVariableColumns(set *Set_points_2d, dataFileName string, variableColumns []string) error {
+
+	if len(variableColumns) == 0 {
+		return nil
+	}
+
+	column := make([]uint8, len(variableColumns))
+
+	for i := range variableColumns {
+		num_column, err := strconv.Atoi(variableColumns[i])
+		if err != nil {
+			return errors.New("variable column expected to be numeric: " + err.Error())
+		}
+		column[i] = uint8(num_column)
+	}
+
+	dataFile, err := os.Open(dataFileName)
+	if err != nil {
+		return errors.New("fail attempting to open Go-Plot data file: " + err.Error())
+	}
+	defer dataFile.Close()
+
+	row, err := LoadDataColumns(column, bufio.NewReader(dataFile))
+	if err != nil {
+		return errors.New("fail attempting to load Go-Plot data file: " + err.Error())
+	}
+	if len(row) != len(set.Point) {
+		return errors.New("variable columns expected to have a value for each point: " + strings.Join(variableColumns, ":"))
+	}
+
+	for i := range set.Point {
+		j := 0
+
+		if set.Line_style.Variable_size {
+			set.Point[i].Size = &row[i][j]
+			j++
+		}
+		if set.Line_style.Colour_source != COLOUR_FIXED {
+			set.Point[i].Colour = &row[i][j]
+		}
+	}
+
+	return nil
+}
+
+//	parseBarSize parse the size of the end caps of error bars in a set or unset bars command: [small|large|size]
+func parseBarSize(command, size string) (float64, error) {
+
+	if command == "unset" {
+		if len(size) > 0 {
+			return 0, errors.New("invalid bars option: " + size)
+		}
+		return 0, nil
+	}
+
+	switch size {
+	case "", "large":
+		return 1, nil
+
+	case "small":
+		return 0, nil
+	}
+
+	num_size, err := strconv.ParseFloat(size, 64)
+	if err != nil || num_size < 0 {
+		return 0, errors.New("invalid bars size: " + size)
+	}
+
+	return num_size, nil
+}
+
+//	parseTerminalOptions parse the options that follow the terminal type in a set terminal command
+func parseTerminalOptions(terminalType uint8, options string) (*TerminalOptions, error) {
+
+	sizeOptionRegEx, err := regexp.Compile(`^\s*size\s+(\d+)\s*,\s*(\d+)\s*`)
+	if err != nil {
+		return nil, err
+	}
+
+	fontOptionRegEx, err := regexp.Compile(`^\s*font\s+"([^",]*)(,(\d+)){0,1}"\s*`)
+	if err != nil {
+		return nil, err
+	}
+
+	backgroundOptionRegEx, err := regexp.Compile(`^\s*background\s+(rgb\s+){0,1}"([^"]+)"\s*`)
+	if err != nil {
+		return nil, err
+	}
+
+	qualityOptionRegEx, err := regexp.Compile(`^\s*quality\s+(\d+)\s*`)
+	if err != nil {
+		return nil, err
+	}
 
-				//	when a comma is found in the scope of a plot command, add the data file points
-				match = commaSeparatorRegEx.FindAllStringSubmatch(line, -1)
-				if len(match) == 1 {
-					if !plotScope {
-						return nil, errors.New("unexpected syntax: " + match[0][1])
-					}
-					if len(dataFileName) > 0 && len(function) > 0 {
-						return nil, errors.New("function and data file must be described separate in plot command")
-					}
+	dynamicOptionRegEx, err := regexp.Compile(`^\s*(dynamic|fixed)\s*`)
+	if err != nil {
+		return nil, err
+	}
 
-					//	if a data file name was found, add the set of points
-					if len(dataFileName) > 0 {
-						auxSetPoints, err := newSetPoints2D(dataFileName, x_column, y_column, style, title)
-						if err != nil {
-							return nil, err
-						}
+	nameOptionRegEx, err := regexp.Compile(`^\s*name\s+"([^"]+)"\s*`)
+	if err != nil {
+		return nil, err
+	}
 
-						//	erase file name as it was used already
-						dataFileName = ""
-						x_column = "1"
-						y_column = "2"
+	var terminalOptions TerminalOptions
 
-						plot.Set_points = append(plot.Set_points, *auxSetPoints)
-						plot.Set_points[len(plot.Set_points)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
-					}
+	for {
+		if len(options) == 0 {
+			break
+		}
 
-					//	if function was found, add it
-					if len(function) > 0 {
-						fmt.Printf("[debug] [2] new function: %s\n", function)
-						auxFunction, err := newFunction2D(function, min_x, max_x, style, title)
-						if err != nil {
-							return nil, err
-						}
+		match := sizeOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			terminalOptions.Width, _ = strconv.ParseInt(match[0][1], 10, 64)
+			terminalOptions.Height, _ = strconv.ParseInt(match[0][2], 10, 64)
 
-						//	erase function as it was used already
-						function = ""
+			if terminalOptions.Width == 0 || terminalOptions.Height == 0 {
+				return nil, errors.New("invalid terminal size: " + match[0][0])
+			}
 
-						plot.Function = append(plot.Function, *auxFunction)
-						plot.Function[len(plot.Function)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
-					}
+			options = options[len(match[0][0]):]
+			continue
+		}
 
-					style = DEFAULT_STYLE
-					title = ""
+		match = fontOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			terminalOptions.Font_family = match[0][1]
 
-					line = line[len(match[0][0]):]
-					continue
+			if len(match[0][3]) > 0 {
+				fontSize, err := strconv.ParseUint(match[0][3], 10, 8)
+				if err != nil || fontSize == 0 {
+					return nil, errors.New("invalid font size: " + match[0][3])
 				}
+				terminalOptions.Font_size = uint8(fontSize)
+			}
 
-				//	TODO: need to parse the function here !
-				//	the rest is the function
-				match = functionRegEx.FindAllStringSubmatch(line, -1)
-				if len(match) == 1 {
-					if !plotScope {
-						return nil, errors.New("function specification without a plot command: " + match[0][0])
-					}
+			options = options[len(match[0][0]):]
+			continue
+		}
 
-					//	if function was found before, add it
-					if len(function) > 0 {
-						fmt.Printf("[debug] [3] new function: %s\n", function)
-						auxFunction, err := newFunction2D(function, min_x, max_x, style, title)
-						if err != nil {
-							return nil, err
-						}
+		match = backgroundOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			background, err := ParseColour(match[0][2])
+			if err != nil {
+				return nil, errors.New("invalid terminal background: " + err.Error())
+			}
+			terminalOptions.Background = &background
 
-						plot.Function = append(plot.Function, *auxFunction)
-						plot.Function[len(plot.Function)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
-					}
+			options = options[len(match[0][0]):]
+			continue
+		}
 
-					function = match[0][1]
-					fmt.Printf("[debug] function found: %s\n", function)
+		match = qualityOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			if terminalType != TERMINAL_JPEG {
+				return nil, errors.New("quality option is only valid for jpeg terminal")
+			}
 
-					line = line[len(match[0][0]):]
-					continue
-				}
+			terminalOptions.Quality, _ = strconv.Atoi(match[0][1])
+			if terminalOptions.Quality < 1 || terminalOptions.Quality > 100 {
+				return nil, errors.New("jpeg quality expected to be between 1 and 100: " + match[0][1])
 			}
+
+			options = options[len(match[0][0]):]
+			continue
 		}
-	}
 
-	if plotScope && len(dataFileName) > 0 && len(function) > 0 {
-		return nil, errors.New("function and data file must be described separate in plot command")
-	}
+		match = dynamicOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			if terminalType != TERMINAL_SVG {
+				return nil, errors.New(match[0][1] + " option is only valid for svg terminal")
+			}
+			terminalOptions.Dynamic = match[0][1] == "dynamic"
 
-	//	when plot file parsing finishes, if a plot command whose data file was not loaded yet, it's the time for it
-	if plotScope && len(dataFileName) > 0 {
-		auxSetPoints, err := newSetPoints2D(dataFileName, x_column, y_column, style, title)
-		if err != nil {
-			return nil, err
+			options = options[len(match[0][0]):]
+			continue
 		}
 
-		plot.Set_points = append(plot.Set_points, *auxSetPoints)
-		plot.Set_points[len(plot.Set_points)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
-	}
+		match = nameOptionRegEx.FindAllStringSubmatch(options, -1)
+		if len(match) == 1 {
+			if terminalType != TERMINAL_CANVAS {
+				return nil, errors.New("name option is only valid for canvas terminal")
+			}
+			err = ValidateCanvasName(match[0][1])
+			if err != nil {
+				return nil, err
+			}
+			terminalOptions.Name = match[0][1]
 
-	//	when plot file parsing finishes, if a plot command whose function was not loaded yet, it's the time for it
-	if plotScope && len(function) > 0 {
-		fmt.Printf("[debug] [4] new function: %s\n", function)
-		auxFunction, err := newFunction2D(function, min_x, max_x, style, title)
-		if err != nil {
-			return nil, err
+			options = options[len(match[0][0]):]
+			continue
 		}
 
-		plot.Function = append(plot.Function, *auxFunction)
-		plot.Function[len(plot.Function)-1].order = uint8(len(plot.Set_points) + len(plot.Function))
+		return nil, errors.New("invalid terminal option: " + options)
 	}
 
-	return plot, nil
+	return &terminalOptions, nil
 }
 
 //	newFunction2D parse string parameters and attempt to create a new function 2D
@@ -438,20 +2299,275 @@ func newFunction2D(function, min_x, max_x, styleDesc, title string) (*Function_2
 	}, nil
 }
 
-//	newSetPoints2D parse string parameters and attempt to create a new set of 2D points
-func newSetPoints2D(dataFileName, x_column, y_column, styleDesc, title string) (*Set_points_2d, error) {
+//	newContourFunction2D create a function of x and y plotted as contours from the plot file parameters
+func newContourFunction2D(function, min_x, max_x, min_y, max_y, styleDesc, title string) (*Function_2d, error) {
+
+	auxFunction, err := newFunction2D(function, min_x, max_x, styleDesc, title)
+	if err != nil {
+		return nil, err
+	}
+
+	auxFunction.Min_y, err = strconv.ParseFloat(min_y, 64)
+	if err != nil {
+		return nil, errors.New("min y expected to be numeric: " + err.Error())
+	}
+	auxFunction.Max_y, err = strconv.ParseFloat(max_y, 64)
+	if err != nil {
+		return nil, errors.New("max y expected to be numeric: " + err.Error())
+	}
+
+	return auxFunction, nil
+}
+
+//	newFunction3D create a function of x and y plotted by a splot command from the plot file parameters
+func newFunction3D(function, min_x, max_x, min_y, max_y, styleDesc, title string) (*Function_3d, error) {
+
+	auxFunction, err := newContourFunction2D(function, min_x, max_x, min_y, max_y, "lines", title)
+	if err != nil {
+		return nil, err
+	}
+
+	num_style, found := Style_3d[styleDesc]
+	if !found {
+		return nil, errors.New("invalid style for splot: " + styleDesc)
+	}
+
+	return &Function_3d{
+		Title:    auxFunction.Title,
+		Style:    num_style,
+		Function: function,
+		Min_x:    auxFunction.Min_x,
+		Max_x:    auxFunction.Max_x,
+		Min_y:    auxFunction.Min_y,
+		Max_y:    auxFunction.Max_y,
+	}, nil
+}
+
+//	newSetPoints3D attempt to create a new set of 3D points plotted by a splot command, from the x:y:z columns of a
+//	data file or from a uniform matrix, where each value is placed at it's column and row
+func newSetPoints3D(dataFileName string, usingColumns []string, matrix bool, styleDesc, title string) (*Set_points_3d, error) {
+
+	num_style, found := Style_3d[styleDesc]
+	if !found {
+		return nil, errors.New("invalid style for splot: " + styleDesc)
+	}
+
+	//	the columns of a splot are x:y:z
+	columns := usingColumns
+	if len(columns) == 0 {
+		columns = []string{"1", "2", "3"}
+	}
+	if matrix && len(usingColumns) > 0 {
+		return nil, errors.New("using option is not supported for matrix data: " + dataFileName)
+	}
+	if !matrix && len(columns) != 3 {
+		return nil, errors.New("splot requires the x, y and z columns: " + strings.Join(columns, ":"))
+	}
+
+	//	constant columns are loaded as the line number and replaced by their value
+	column := make([]uint8, len(columns))
+	constant := make(map[int]float64)
+
+	for i := range columns {
+		num_column, column_constant, err := parseUsingColumn(columns[i])
+		if err != nil {
+			return nil, errors.New("column expected to be numeric: " + err.Error())
+		}
+		if column_constant != nil {
+			constant[i] = *column_constant
+		}
+		column[i] = num_column
+	}
+
+	//	open the Go-Plot data file and load it
+	dataFile, err := os.Open(dataFileName)
+	if err != nil {
+		return nil, errors.New("fail attempting to open Go-Plot data file: " + err.Error())
+	}
+	defer dataFile.Close()
+
+	point := make([]Point_3d, 0)
+
+	if matrix {
+		value, err := LoadDataMatrix(bufio.NewReader(dataFile))
+		if err != nil {
+			return nil, errors.New("fail attempting to load Go-Plot data file: " + err.Error())
+		}
+
+		for j := range value {
+			for i := range value[j] {
+				point = append(point, Point_3d{X: float64(i), Y: float64(j), Z: value[j][i]})
+			}
+		}
+	} else {
+		row, err := LoadDataColumns(column, bufio.NewReader(dataFile))
+		if err != nil {
+			return nil, errors.New("fail attempting to load Go-Plot data file: " + err.Error())
+		}
+
+		for i := range row {
+			for j, value := range constant {
+				row[i][j] = value
+			}
+			point = append(point, Point_3d{X: row[i][0], Y: row[i][1], Z: row[i][2]})
+		}
+	}
+
+	//	set a default title when necessary
+	if len(title) == 0 {
+		if matrix {
+			title = dataFileName + " matrix"
+		} else {
+			title = dataFileName + " u " + strings.Join(columns, ":")
+		}
+	}
+
+	return &Set_points_3d{
+		Title: title,
+		Style: num_style,
+		Point: point,
+	}, nil
+}
+
+//	parseView parse the point of view of 3D plots: the map view looks at the plane x, y from above
+func parseView(view, rot_x, rot_z, scale string) (*View, error) {
+
+	if view == "map" {
+		return &View{Rot_x: 0, Rot_z: 0, Scale: 1}, nil
+	}
+
+	var err error
+
+	result := &View{Scale: 1}
+
+	result.Rot_x, err = strconv.ParseFloat(rot_x, 64)
+	if err != nil {
+		return nil, errors.New("view rotation expected to be numeric: " + rot_x)
+	}
+	result.Rot_z, err = strconv.ParseFloat(rot_z, 64)
+	if err != nil {
+		return nil, errors.New("view rotation expected to be numeric: " + rot_z)
+	}
+	if len(scale) > 0 {
+		result.Scale, err = strconv.ParseFloat(scale, 64)
+		if err != nil {
+			return nil, errors.New("view scale expected to be numeric: " + scale)
+		}
+	}
+
+	err = result.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//	newParametricFunction2D create a parametric function from the plot file parameters
+func newParametricFunction2D(function_x, function_y, min_t, max_t, styleDesc, title string) (*Function_2d, error) {
+
+	num_min_t, err := strconv.ParseFloat(min_t, 64)
+	if err != nil {
+		return nil, errors.New("min t expected to be numeric: " + err.Error())
+	}
+
+	num_max_t, err := strconv.ParseFloat(max_t, 64)
+	if err != nil {
+		return nil, errors.New("max t expected to be numeric: " + err.Error())
+	}
+
+	num_style, found := Style[styleDesc]
+	if !found {
+		return nil, errors.New("invalid style: " + styleDesc)
+	}
+
+	//	set a default title when necessary
+	if len(title) == 0 {
+		title = function_x + ", " + function_y
+	}
+
+	return &Function_2d{
+		Title:      title,
+		Style:      num_style,
+		Function:   function_x,
+		Parametric: true,
+		Function_y: function_y,
+		Min_t:      num_min_t,
+		Max_t:      num_max_t,
+	}, nil
+}
+
+//	newPolarFunction2D create a polar function r(t) from the plot file parameters
+func newPolarFunction2D(function, min_t, max_t, styleDesc, title string) (*Function_2d, error) {
+
+	num_min_t, err := strconv.ParseFloat(min_t, 64)
+	if err != nil {
+		return nil, errors.New("min t expected to be numeric: " + err.Error())
+	}
+
+	num_max_t, err := strconv.ParseFloat(max_t, 64)
+	if err != nil {
+		return nil, errors.New("max t expected to be numeric: " + err.Error())
+	}
+
+	num_style, found := Style[styleDesc]
+	if !found {
+		return nil, errors.New("invalid style: " + styleDesc)
+	}
+
+	//	set a default title when necessary
+	if len(title) == 0 {
+		title = function
+	}
+
+	return &Function_2d{
+		Title:    title,
+		Style:    num_style,
+		Function: function,
+		Min_t:    num_min_t,
+		Max_t:    num_max_t,
+	}, nil
+}
+
+//	newSetPoints2D parse string parameters and attempt to create a new set of 2D points (extra columns are used by the style)
+func newSetPoints2D(dataFileName, x_column, y_column, styleDesc, title string, extra_column ...string) (*Set_points_2d, error) {
+
+	//	constant columns are loaded as the line number and replaced by their value
+	constant := make(map[int]float64)
 
 	//	attempt to convert x_column to an int
-	num_x_column, err := strconv.Atoi(x_column)
+	num_x_column, x_constant, err := parseUsingColumn(x_column)
 	if err != nil {
 		return nil, errors.New("x column expected to be numeric: " + err.Error())
 	}
+	if x_constant != nil {
+		constant[0] = *x_constant
+	}
 
 	//	attempt to convert y_column to an int
-	num_y_column, err := strconv.Atoi(y_column)
+	num_y_column, y_constant, err := parseUsingColumn(y_column)
 	if err != nil {
 		return nil, errors.New("y column expected to be numeric: " + err.Error())
 	}
+	if y_constant != nil {
+		constant[1] = *y_constant
+	}
+
+	//	attempt to convert the extra columns to int
+	column := []uint8{num_x_column, num_y_column}
+	columnDesc := x_column + ":" + y_column
+
+	for i, extra := range extra_column {
+		num_column, extra_constant, err := parseUsingColumn(extra)
+		if err != nil {
+			return nil, errors.New("column expected to be numeric: " + err.Error())
+		}
+		if extra_constant != nil {
+			constant[2+i] = *extra_constant
+		}
+		column = append(column, num_column)
+		columnDesc += ":" + extra
+	}
 
 	//	open the Go-Plot data file and load it
 	dataFile, err := os.Open(dataFileName)
@@ -459,11 +2575,17 @@ func newSetPoints2D(dataFileName, x_column, y_column, styleDesc, title string) (
 		return nil, errors.New("fail attempting to open Go-Plot data file: " + err.Error())
 	}
 	defer dataFile.Close()
-	point, err := LoadDataFile(uint8(num_x_column), uint8(num_y_column), bufio.NewReader(dataFile))
+	row, err := LoadDataColumns(column, bufio.NewReader(dataFile))
 	if err != nil {
 		return nil, errors.New("fail attempting to load Go-Plot data file: " + err.Error())
 	}
 
+	for i := range row {
+		for j, value := range constant {
+			row[i][j] = value
+		}
+	}
+
 	//	attempt to convert the style string to an int constant
 	var num_style uint8
 	var found bool
@@ -473,9 +2595,108 @@ func newSetPoints2D(dataFileName, x_column, y_column, styleDesc, title string) (
 		return nil, errors.New("invalid style: " + styleDesc)
 	}
 
+	//	the extra columns give the error extents of each point
+	if !validExtraColumns(num_style, len(extra_column)) {
+		return nil, errors.New("invalid number of columns for style " + styleDesc + ": " + columnDesc)
+	}
+
+	point := make([]Point_2d, len(row))
+
+	for i := range row {
+		point[i] = Point_2d{X: row[i][0], Y: row[i][1]}
+
+		//	the columns of candlesticks and financebars are date:open:low:high:close
+		if num_style == CANDLESTICKS || num_style == FINANCEBARS {
+			point[i] = newFinancialPoint(row[i][0], row[i][1], row[i][2], row[i][3], row[i][4])
+			continue
+		}
+
+		//	the columns of vectors are x:y:dx:dy
+		if num_style == VECTORS {
+			point[i] = newVectorPoint(row[i][0], row[i][1], row[i][2], row[i][3])
+			continue
+		}
+
+		//	the third column of images and contours is the value mapped into the palette
+		if num_style == IMAGE || num_style == CONTOURS {
+			point[i].Colour = &row[i][2]
+			continue
+		}
+
+		//	the third column of circles is their radius
+		if num_style == CIRCLES {
+			if len(row[i]) == 3 {
+				point[i].Size = &row[i][2]
+			}
+			continue
+		}
+
+		//	the samples of box-and-whisker and violin plots are grouped by x
+		if num_style == BOXPLOT || num_style == VIOLIN {
+			continue
+		}
+
+		//	the third column of a filled curve is the other limit of the area
+		if num_style == FILLED_CURVES {
+			if len(row[i]) == 3 {
+				point[i].Y_to = &row[i][2]
+			}
+			continue
+		}
+		point[i].Error = newError2D(num_style, row[i][0], row[i][1], row[i][2:])
+	}
+
+	//	set a default title when necessary
+	if len(title) == 0 {
+		title = fmt.Sprintf("%s u %s", dataFileName, columnDesc)
+	}
+
+	set := &Set_points_2d{
+		Title: title,
+		Style: num_style,
+		Point: point,
+	}
+
+	//	the third column of box-and-whisker and violin plots is the width of the boxes
+	if (num_style == BOXPLOT || num_style == VIOLIN) && len(extra_column) > 0 && len(row) > 0 {
+		set.boxWidth = row[0][2]
+	}
+
+	return set, nil
+}
+
+//	newMatrixPoints2D attempt to create a new set of 2D points from a uniform matrix, where each value is placed at it's
+//	column and row (both starting at 0)
+func newMatrixPoints2D(dataFileName, styleDesc, title string) (*Set_points_2d, error) {
+
+	num_style, found := Style[styleDesc]
+	if !found {
+		return nil, errors.New("invalid style: " + styleDesc)
+	}
+
+	//	open the Go-Plot data file and load it
+	dataFile, err := os.Open(dataFileName)
+	if err != nil {
+		return nil, errors.New("fail attempting to open Go-Plot data file: " + err.Error())
+	}
+	defer dataFile.Close()
+
+	value, err := LoadDataMatrix(bufio.NewReader(dataFile))
+	if err != nil {
+		return nil, errors.New("fail attempting to load Go-Plot data file: " + err.Error())
+	}
+
+	point := make([]Point_2d, 0)
+
+	for j := range value {
+		for i := range value[j] {
+			point = append(point, Point_2d{X: float64(i), Y: float64(j), Colour: &value[j][i]})
+		}
+	}
+
 	//	set a default title when necessary
 	if len(title) == 0 {
-		title = fmt.Sprintf("%s u %d:%d", dataFileName, num_x_column, num_y_column)
+		title = dataFileName + " matrix"
 	}
 
 	return &Set_points_2d{
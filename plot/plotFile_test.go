@@ -8,9 +8,13 @@ package plot
 
 import (
 	"bufio"
+	"math"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/aldebap/go-plot/expression"
 )
 
 // TestLoadPlotFile unit tests for LoadPlotFile()
@@ -84,263 +88,1559 @@ func TestLoadPlotFile(t *testing.T) {
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: set output", func(t *testing.T) {
-		want := "graphics.jpg"
+	t.Run(">>> LoadPlotFile: set terminal with options", func(t *testing.T) {
+		want := TerminalOptions{
+			Width:       1024,
+			Height:      768,
+			Font_family: "Verdana",
+			Font_size:   12,
+			Background:  &RGB_colour{red: 0x20, green: 0x20, blue: 0x20},
+		}
 
-		mockPlotFile := strings.NewReader(`set output "` + want + `"`)
+		mockPlotFile := strings.NewReader(`set terminal png size 1024,768 font "Verdana,12" background "#202020"`)
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		got := plot.(*Plot_2D).output
+		got := plot.(*Plot_2D).Terminal_options
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %s result: %s", want, got)
+		if want.Width != got.Width || want.Height != got.Height ||
+			want.Font_family != got.Font_family || want.Font_size != got.Font_size ||
+			got.Background == nil || *want.Background != *got.Background {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot function (default parameters)", func(t *testing.T) {
-
-		expectedSetPoints := 0
-		expectedFunctions := 1
-
-		mockPlotFile := strings.NewReader(`plot sin(x)`)
+	t.Run(">>> LoadPlotFile: set terminal with format specific options", func(t *testing.T) {
+		mockPlotFile := strings.NewReader("set terminal jpeg quality 90\nset terminal svg dynamic\nset terminal canvas name \"myplot\"")
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		got := plot.(*Plot_2D).Terminal_options
+		//	only the options from the last terminal command are expected
+		if got.Name != "myplot" || got.Quality != 0 || got.Dynamic {
+			t.Errorf("failed parsing plot file: expected canvas name 'myplot' result: %v", got)
+		}
+
+		options, err := parseTerminalOptions(TERMINAL_JPEG, "quality 90")
+		if err != nil {
+			t.Errorf("fail parsing terminal options: %s", err.Error())
 			return
 		}
+		if options.Quality != 90 {
+			t.Errorf("failed parsing terminal options: expected quality 90 result: %d", options.Quality)
+		}
 
-		want = expectedFunctions
-		got = len(plot.(*Plot_2D).Function)
+		options, err = parseTerminalOptions(TERMINAL_SVG, "dynamic")
+		if err != nil {
+			t.Errorf("fail parsing terminal options: %s", err.Error())
+			return
+		}
+		if !options.Dynamic {
+			t.Errorf("failed parsing terminal options: expected dynamic svg")
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set terminal with invalid canvas name", func(t *testing.T) {
+		want := "invalid canvas name: my plot"
+
+		mockPlotFile := strings.NewReader(`set terminal canvas name "my plot"`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d functions result: %d", want, got)
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: function without a plot command", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: set terminal with invalid option", func(t *testing.T) {
+		want := "quality option is only valid for jpeg terminal"
 
-		mockPlotFile := strings.NewReader(`sin(x)`)
+		mockPlotFile := strings.NewReader(`set terminal png quality 90`)
 		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err == nil {
 			t.Errorf("error expected loading plot file")
 			return
 		}
 
-		want := `function specification without a plot command: sin(x)`
-		got := strings.TrimRight(err.Error(), " ")
+		got := err
 		//	check the result
-		if want != got {
+		if want != got.Error() {
 			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot function with interval", func(t *testing.T) {
-
-		expectedSetPoints := 0
-		expectedFunctions := 1
+	t.Run(">>> LoadPlotFile: set output", func(t *testing.T) {
+		want := "graphics.jpg"
 
-		mockPlotFile := strings.NewReader(`plot [0:3.14] sin(x)`)
+		mockPlotFile := strings.NewReader(`set output "` + want + `"`)
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		got := plot.(*Plot_2D).output
 		//	check the result
 		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			t.Errorf("failed parsing plot file: expected: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set logscale", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set logscale xy\nset logscale y 2")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want = expectedFunctions
-		got = len(plot.(*Plot_2D).Function)
+		got := plot.(*Plot_2D)
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d functions result: %d", want, got)
+		if !got.X_axis.Log_scale || got.X_axis.Log_base != 10 {
+			t.Errorf("failed parsing plot file: expected x logscale base 10 result: %v", got.X_axis)
+		}
+		if !got.Y_axis.Log_scale || got.Y_axis.Log_base != 2 {
+			t.Errorf("failed parsing plot file: expected y logscale base 2 result: %v", got.Y_axis)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot function with signed interval numbers", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: unset logscale", func(t *testing.T) {
 
-		expectedSetPoints := 0
-		expectedFunctions := 1
+		mockPlotFile := strings.NewReader("set logscale\nunset logscale x")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
 
-		mockPlotFile := strings.NewReader(`plot [-3.14:+3.14] sin(x)`)
+		got := plot.(*Plot_2D)
+		//	check the result
+		if got.X_axis.Log_scale || !got.Y_axis.Log_scale {
+			t.Errorf("failed parsing plot file: expected only y logscale result: %v %v", got.X_axis, got.Y_axis)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set y2label, y2range, y2tics and logscale x2", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set y2label \"errors\"\nset y2range [0:*]\nset y2tics 0.5\nset logscale x2y")
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		got := plot.(*Plot_2D)
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if got.Y2_label != "errors" || got.Y2_axis.Min == nil || *got.Y2_axis.Min != 0 || got.Y2_axis.Max != nil ||
+			got.Y2_axis.Tics == nil || got.Y2_axis.Tics.Increment != 0.5 {
+			t.Errorf("failed parsing plot file: expected y2 axis from 0 with 0.5 tics result: %s %v", got.Y2_label, got.Y2_axis)
+		}
+		if !got.X2_axis.Log_scale || !got.Y_axis.Log_scale || got.X_axis.Log_scale || got.Y2_axis.Log_scale {
+			t.Errorf("failed parsing plot file: expected x2 and y logscale result: %v %v", got.X2_axis, got.Y_axis)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set xrange (invalid range)", func(t *testing.T) {
+		want := "axis range minimum expected to be less than maximum: 5:1"
+
+		mockPlotFile := strings.NewReader(`set xrange [5:1]`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set logscale (invalid axes)", func(t *testing.T) {
+		want := "invalid logscale axes: z"
+
+		mockPlotFile := strings.NewReader(`set logscale z`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
 			return
 		}
 
-		want = expectedFunctions
-		got = len(plot.(*Plot_2D).Function)
+		got := err
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d functions result: %d", want, got)
+		if want != got.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" (default parameters)", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: set xtics series", func(t *testing.T) {
 
-		//	create a temporary data file
-		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		mockPlotFile := strings.NewReader("set xtics 0,0.5,3\nset ytics 2")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail creating plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		got := plot.(*Plot_2D)
+		//	check the result
+		if got.X_axis.Tics == nil || got.X_axis.Tics.Start != 0 || got.X_axis.Tics.Increment != 0.5 || got.X_axis.Tics.End != 3 {
+			t.Errorf("failed parsing plot file: expected x tics 0,0.5,3 result: %v", got.X_axis.Tics)
+		}
+		if got.Y_axis.Tics == nil || got.Y_axis.Tics.Increment != 2 || !math.IsInf(got.Y_axis.Tics.Start, -1) {
+			t.Errorf("failed parsing plot file: expected y tics increment 2 result: %v", got.Y_axis.Tics)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set xtics labels", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`set xtics ("low" 0, "high" 10, 20)`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			tmpDataFile.Close()
-			t.Errorf("fail writing to plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		err = tmpDataFile.Close()
-		if err != nil {
-			t.Errorf("fail closing the plot data file: %s", err.Error())
+
+		want := []Tic_label{{Label: "low", Value: 0}, {Label: "high", Value: 10}, {Label: "", Value: 20}}
+		got := plot.(*Plot_2D).X_axis.Tic_labels
+		//	check the result
+		if len(want) != len(got) {
+			t.Errorf("failed parsing plot file: expected: %d tic labels result: %d", len(want), len(got))
 			return
 		}
+		for i := range want {
+			if want[i] != got[i] {
+				t.Errorf("failed parsing plot file: expected: %v result: %v", want[i], got[i])
+			}
+		}
+	})
 
-		expectedSetPoints := 1
-		expectedPoints := 2
+	t.Run(">>> LoadPlotFile: set mxtics and format", func(t *testing.T) {
 
-		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `"`)
+		mockPlotFile := strings.NewReader("set mxtics 4\nset mytics\nset format y \"%.2f\"")
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		got := plot.(*Plot_2D)
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if got.X_axis.Minor_tics != 4 || got.Y_axis.Minor_tics != MINOR_TICS_AUTO {
+			t.Errorf("failed parsing plot file: expected minor tics 4 and auto result: %d and %d", got.X_axis.Minor_tics, got.Y_axis.Minor_tics)
+		}
+		if got.X_axis.Format != "" || got.Y_axis.Format != "%.2f" {
+			t.Errorf("failed parsing plot file: expected y format %%.2f result: '%s' '%s'", got.X_axis.Format, got.Y_axis.Format)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set format (invalid)", func(t *testing.T) {
+		want := "invalid format specifier: %s"
+
+		mockPlotFile := strings.NewReader(`set format x "%s"`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
 			return
 		}
 
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		got := err
+		//	check the result
+		if want != got.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set grid (default tics)", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`set grid`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := Grid{X_tics: true, Y_tics: true}
+		got := plot.(*Plot_2D).Grid
 		//	check the result
 		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: data file without a plot command", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: set grid with options", func(t *testing.T) {
 
-		//	create a temporary data file
-		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		mockPlotFile := strings.NewReader(`set grid ytics mytics lc rgb "#c0c0c0"`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail creating plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		defer os.Remove(tmpDataFile.Name())
 
-		mockPlotFile := strings.NewReader(`"` + tmpDataFile.Name() + `" using 1:3`)
-		_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
-		if err == nil {
-			t.Errorf("error expected loading plot file")
+		got := plot.(*Plot_2D).Grid
+		//	check the result
+		if got.X_tics || !got.Y_tics || got.X_minor_tics || !got.Y_minor_tics ||
+			got.Line_style.Colour == nil || *got.Line_style.Colour != (RGB_colour{red: 0xc0, green: 0xc0, blue: 0xc0}) {
+			t.Errorf("failed parsing plot file: expected y tics grid in #c0c0c0 result: %v", got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: unset grid", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set grid\nunset grid")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := `data file specification without a plot command: "` + tmpDataFile.Name() + `"`
-		got := strings.TrimRight(err.Error(), " ")
+		got := plot.(*Plot_2D).Grid
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		if got.enabled() {
+			t.Errorf("failed parsing plot file: expected no grid result: %v", got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" using x:y", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: set xzeroaxis with line style", func(t *testing.T) {
 
-		//	create a temporary data file
-		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		mockPlotFile := strings.NewReader(`set xzeroaxis lw 2 dt 2`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail creating plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		got := plot.(*Plot_2D)
+		//	check the result
+		if got.X_zero_axis == nil || got.X_zero_axis.Width != 2 || got.X_zero_axis.Dash_type != DASH_DASHED || got.Y_zero_axis != nil {
+			t.Errorf("failed parsing plot file: expected dashed x zero axis result: %v, %v", got.X_zero_axis, got.Y_zero_axis)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: unset yzeroaxis", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set zeroaxis\nunset yzeroaxis")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			tmpDataFile.Close()
-			t.Errorf("fail writing to plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		err = tmpDataFile.Close()
+
+		got := plot.(*Plot_2D)
+		//	check the result
+		if got.X_zero_axis == nil || got.Y_zero_axis != nil {
+			t.Errorf("failed parsing plot file: expected only x zero axis result: %v, %v", got.X_zero_axis, got.Y_zero_axis)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set yzeroaxis with invalid option", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`set yzeroaxis lw thick`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+
+		want := "invalid zero axis line style: invalid line style option: lw thick"
+		//	check the result
+		if err == nil || err.Error() != want {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set label", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`set label 2 "release" at graph 0.5, first 3 right rotate by 90 font "Verdana,12"`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail closing the plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		expectedSetPoints := 1
-		expectedPoints := 2
+		want := Annotation{
+			Type:          ANNOTATION_LABEL,
+			Tag:           2,
+			Text:          "release",
+			Position:      []Coordinate{{X: 0.5, Y: 3, X_system: COORDINATES_GRAPH, Y_system: COORDINATES_FIRST}},
+			Angle:         90,
+			Justification: JUSTIFY_RIGHT,
+			Font_family:   "Verdana",
+			Font_size:     12,
+		}
+		got := plot.(*Plot_2D).Annotations
+		//	check the result
+		if len(got) != 1 || !reflect.DeepEqual(want, got[0]) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
+		}
+	})
 
-		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:3`)
+	t.Run(">>> LoadPlotFile: set arrow", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`set arrow from screen 0.1,0.2 to 3,4 nohead lw 2`)
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		want := Annotation{
+			Type: ANNOTATION_ARROW,
+			Tag:  1,
+			Position: []Coordinate{
+				{X: 0.1, Y: 0.2, X_system: COORDINATES_SCREEN, Y_system: COORDINATES_SCREEN},
+				{X: 3, Y: 4},
+			},
+			Head:       ARROW_NOHEAD,
+			Line_style: Line_style{Width: 2},
+		}
+		got := plot.(*Plot_2D).Annotations
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if len(got) != 1 || !reflect.DeepEqual(want, got[0]) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set object replaced by tag and unset", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set object rect from 1,1 to 2,2\n" +
+			"set object circle at 0,0 size 1\n" +
+			"set object 1 polygon from 0,0 to 1,0 to 1,1 fc rgb \"red\" front\n" +
+			"unset object 2")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		got := plot.(*Plot_2D).Annotations
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		if len(got) != 1 || got[0].Type != ANNOTATION_POLYGON || len(got[0].Position) != 3 ||
+			got[0].Fill_colour == nil || *got[0].Fill_colour != RED || !got[0].front() {
+			t.Errorf("failed parsing plot file: expected a single polygon result: %v", got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: using option without a plot command", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: set arrow (missing position)", func(t *testing.T) {
+		want := "not enough positions for the annotation: from 1,2"
 
-		mockPlotFile := strings.NewReader(`using 1:3`)
+		mockPlotFile := strings.NewReader(`set arrow from 1,2`)
 		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err == nil {
 			t.Errorf("error expected loading plot file")
 			return
 		}
 
-		want := "'using' option without a plot command: using 1:3"
-		got := err
+		got := err.Error()
 		//	check the result
-		if want != got.Error() {
+		if want != got {
 			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" with boxes", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: plot function (default parameters)", func(t *testing.T) {
+
+		expectedSetPoints := 0
+		expectedFunctions := 1
+
+		mockPlotFile := strings.NewReader(`plot sin(x)`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedFunctions
+		got = len(plot.(*Plot_2D).Function)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d functions result: %d", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: function without a plot command", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`sin(x)`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		want := `function specification without a plot command: sin(x)`
+		got := strings.TrimRight(err.Error(), " ")
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot function with interval", func(t *testing.T) {
+
+		expectedSetPoints := 0
+		expectedFunctions := 1
+
+		mockPlotFile := strings.NewReader(`plot [0:3.14] sin(x)`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedFunctions
+		got = len(plot.(*Plot_2D).Function)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d functions result: %d", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot function with signed interval numbers", func(t *testing.T) {
+
+		expectedSetPoints := 0
+		expectedFunctions := 1
+
+		mockPlotFile := strings.NewReader(`plot [-3.14:+3.14] sin(x)`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedFunctions
+		got = len(plot.(*Plot_2D).Function)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d functions result: %d", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot functions with line style options", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`plot [0:3.14] sin(x) lc rgb "#0060ad" lw 2 dt 2 title "sine", cos(x) with lines pt 7 ps 1.5`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		function := plot.(*Plot_2D).Function
+		//	check the result
+		if len(function) != 2 {
+			t.Errorf("failed parsing plot file: expected: 2 functions result: %d", len(function))
+			return
+		}
+
+		got := function[0]
+		if got.Function != "sin(x)" || got.Title != "sine" || got.Line_style.Width != 2 || got.Line_style.Dash_type != DASH_DASHED ||
+			got.Line_style.Colour == nil || *got.Line_style.Colour != (RGB_colour{red: 0x00, green: 0x60, blue: 0xad}) {
+			t.Errorf("failed parsing plot file: unexpected first function: %v", got)
+		}
+
+		got = function[1]
+		if got.Function != "cos(x)" || got.Style != LINES || got.Line_style.Colour != nil ||
+			got.Line_style.Point_type != 7 || got.Line_style.Point_size != 1.5 {
+			t.Errorf("failed parsing plot file: unexpected second function: %v", got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set style line referenced by ls", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set style line 3 lc \"red\" lw 3 dt 4\nplot sin(x) ls 3 lw 1.5")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got := plot.(*Plot_2D).Function[0].Line_style
+		//	check the result (options after ls override the line style)
+		if got.Colour == nil || *got.Colour != RED || got.Width != 1.5 || got.Dash_type != DASH_DOT {
+			t.Errorf("failed parsing plot file: unexpected line style: %v", got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: undefined line style", func(t *testing.T) {
+		want := "undefined line style: 2"
+
+		mockPlotFile := strings.NewReader(`plot sin(x) ls 2`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		got := err.Error()
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: invalid dash type", func(t *testing.T) {
+		want := "invalid dash type: 9"
+
+		mockPlotFile := strings.NewReader(`plot sin(x) dt 9`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		got := err.Error()
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" (default parameters)", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `"`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: data file without a plot command", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		mockPlotFile := strings.NewReader(`"` + tmpDataFile.Name() + `" using 1:3`)
+		_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		want := `data file specification without a plot command: "` + tmpDataFile.Name() + `"`
+		got := strings.TrimRight(err.Error(), " ")
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" using x:y", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:3`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: using option without a plot command", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`using 1:3`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		want := "'using' option without a plot command: using 1:3"
+		got := err
+		//	check the result
+		if want != got.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" with boxes", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+		expectedStyle := "boxes"
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" with ` + expectedStyle)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+
+		want = int(Style[expectedStyle])
+		got = int(plot.(*Plot_2D).Set_points[0].Style)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: with option without a plot command", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`with boxes`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		want := "'with' option without a plot command: with boxes"
+		got := err
+		//	check the result
+		if want != got.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" with slopes", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		want := "invalid style: slopes"
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" with slopes`)
+		_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		got := err
+		//	check the result
+		if want != got.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" title \"description\"", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+		expectedTitle := "annual inflation"
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" title "` + expectedTitle + `"`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+
+		wantString := expectedTitle
+		gotString := plot.(*Plot_2D).Set_points[0].Title
+		//	check the result
+		if wantString != gotString {
+			t.Errorf("failed parsing plot file: expected: %s result: %s", wantString, gotString)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: title option without a plot command", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`title "outside a plot"`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil {
+			t.Errorf("error expected loading plot file")
+			return
+		}
+
+		want := `'title' option without a plot command: title "outside a plot"`
+		got := err
+		//	check the result
+		if want != got.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" using 1:3 with boxes (multiple options)", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+		expectedStyle := "boxes"
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:3 with ` + expectedStyle)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+
+		want = int(Style[expectedStyle])
+		got = int(plot.(*Plot_2D).Set_points[0].Style)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" using 1:3 with boxes (options in multiple lines)", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+		expectedStyle := "boxes"
+
+		mockPlotFile := strings.NewReader("plot \"" + tmpDataFile.Name() + "\"\nusing 1:3\nwith " + expectedStyle)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+
+		want = int(Style[expectedStyle])
+		got = int(plot.(*Plot_2D).Set_points[0].Style)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot \"file\" using 1:3 with boxes (followed by a global command)", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		expectedSetPoints := 1
+		expectedPoints := 2
+		expectedStyle := "boxes"
+		expectedTerminal := "canvas"
+
+		mockPlotFile := strings.NewReader("plot \"" + tmpDataFile.Name() + "\"\nusing 1:3\nwith " + expectedStyle + "\n" +
+			"set terminal " + expectedTerminal)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := expectedSetPoints
+		got := len(plot.(*Plot_2D).Set_points)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+			return
+		}
+
+		want = expectedPoints
+		got = len(plot.(*Plot_2D).Set_points[0].Point)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		}
+
+		want = int(Style[expectedStyle])
+		got = int(plot.(*Plot_2D).Set_points[0].Style)
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+		}
+
+		wantString := expectedTerminal
+		gotString := plot.(*Plot_2D).Terminal
+		//	check the result
+		if terminal[wantString] != gotString {
+			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", terminal[wantString], wantString, gotString)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot with axes option", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader(`plot sin(x) axes x1y2 title "sin", cos(x) axes x2y2`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := []uint8{AXES_X1Y2, AXES_X2Y2}
+		var got []uint8
+
+		for _, function := range plot.(*Plot_2D).Function {
+			got = append(got, function.Axes)
+		}
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed parsing plot file: expected axes: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot with invalid axes", func(t *testing.T) {
+		want := "invalid axes: x3y1"
+
+		mockPlotFile := strings.NewReader(`plot sin(x) axes x3y1`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set multiplot layout", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set multiplot layout 1,2 title \"panels\"\nset xlabel \"a\"\nplot sin(x)\nset xlabel \"b\"\nplot cos(x), x\nunset multiplot")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got, ok := plot.(*Multiplot)
+		if !ok {
+			t.Errorf("failed parsing plot file: expected a multiplot result: %v", plot)
+			return
+		}
+		//	check the result
+		if got.Title != "panels" || got.Rows != 1 || got.Columns != 2 || len(got.Panels) != 2 {
+			t.Errorf("failed parsing plot file: expected 1,2 layout with 2 panels result: %v", got)
+			return
+		}
+		if got.Panels[0].X_label != "a" || len(got.Panels[0].Function) != 1 || got.Panels[1].X_label != "b" || len(got.Panels[1].Function) != 2 {
+			t.Errorf("failed parsing plot file: expected panels with their own settings result: %v %v", got.Panels[0], got.Panels[1])
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: plot command after unset multiplot", func(t *testing.T) {
+		want := "plot command after unset multiplot"
+
+		mockPlotFile := strings.NewReader("set multiplot layout 2,1\nplot sin(x)\nunset multiplot\nplot cos(x)")
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set parametric and trange", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set parametric\nset trange [0:6.28]\nplot cos(t), sin(t) title \"circle\", [0:1] t, t*t")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := []Function_2d{
+			{Title: "circle", Style: POINTS, Function: "cos(t)", Parametric: true, Function_y: "sin(t)", Min_t: 0, Max_t: 6.28, order: 1},
+			{Title: "t, t*t", Style: POINTS, Function: "t", Parametric: true, Function_y: "t*t", Min_t: 0, Max_t: 1, order: 2},
+		}
+		got := plot.(*Plot_2D).Function
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: parametric plot with a single function", func(t *testing.T) {
+		want := "parametric plot requires functions for x and y: cos(t)"
+
+		mockPlotFile := strings.NewReader("set parametric\nplot cos(t) title \"x only\"")
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set and unset bars", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set bars 2.5")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got := plot.(*Plot_2D).Bar_size
+		//	check the result
+		if got == nil || *got != 2.5 {
+			t.Errorf("failed parsing plot file: expected bars size 2.5 result: %v", got)
+		}
+
+		mockPlotFile = strings.NewReader("set bars large\nunset bars")
+		plot, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got = plot.(*Plot_2D).Bar_size
+		//	check the result
+		if got == nil || *got != 0 {
+			t.Errorf("failed parsing plot file: expected no bars result: %v", got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: function with filledcurves and fill style", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("plot [0:3] x*x with filledcurves x1 fs transparent solid 0.3 title \"area\"")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := []Function_2d{{
+			Title:         "area",
+			Style:         FILLED_CURVES,
+			Fill:          Fill_style{Type: FILL_SOLID, Density: 0.3, Transparent: true},
+			Filled_curves: Filled_curves{Reference: FILLED_X1},
+			Function:      "x*x",
+			Min_x:         0,
+			Max_x:         3,
+			order:         1,
+		}}
+		got := plot.(*Plot_2D).Function
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set polar, angles and polar grid", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set polar\nset angles degrees\nset grid polar 45\nplot [0:360] 1+cos(t) title \"cardioid\"")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := []Function_2d{
+			{Title: "cardioid", Style: POINTS, Function: "1+cos(t)", Min_t: 0, Max_t: 360, order: 1},
+		}
+		got := plot.(*Plot_2D)
+		//	check the result
+		if !reflect.DeepEqual(want, got.Function) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got.Function)
+		}
+		if !got.Polar || got.Angles != expression.DEGREES || !got.Grid.Polar || got.Grid.Polar_step != 45 {
+			t.Errorf("failed parsing plot file: expected polar settings result: %v %v %v", got.Polar, got.Angles, got.Grid)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: invalid angles unit", func(t *testing.T) {
+		want := "invalid angles unit: gradians"
+
+		mockPlotFile := strings.NewReader("set angles gradians\nplot x")
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: set style histogram rowstacked gap 1", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set style histogram rowstacked gap 1\nplot x")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		want := Histogram_style{Layout: HISTOGRAM_ROWSTACKED, Gap: 1}
+		got := plot.(*Plot_2D).Histogram
+		//	check the result
+		if want != got {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: invalid histogram layout", func(t *testing.T) {
+		want := "invalid histogram layout: stacked"
+
+		mockPlotFile := strings.NewReader("set style histogram stacked\nplot x")
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: histograms with xtic labels in multiplot panels", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("week cpu mem\nw1 20 30\nw2 40 10\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		mockPlotFile := strings.NewReader("set multiplot layout 1,2\n" +
+			`plot "` + tmpDataFile.Name() + `" using 2:xtic(1) with histograms, "` + tmpDataFile.Name() + `" using 3 with histograms` + "\n" +
+			`plot "` + tmpDataFile.Name() + `" using 2 with boxes`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		panels := plot.(*Multiplot).Panels
+		if len(panels) != 2 {
+			t.Errorf("failed parsing plot file: expected: 2 panels result: %d", len(panels))
+			return
+		}
+
+		want := []Tic_label{{Label: "w1", Value: 0}, {Label: "w2", Value: 1}}
+		got := panels[0].X_axis.Tic_labels
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got)
+		}
+		if panels[0].Set_points[1].Title != tmpDataFile.Name()+" u 3" || panels[0].Set_points[1].Point[1].X != 1 {
+			t.Errorf("failed parsing plot file: expected the rows as x result: %v", panels[0].Set_points[1])
+		}
+		if len(panels[1].X_axis.Tic_labels) != 0 {
+			t.Errorf("failed parsing plot file: expected no tic labels in the next panel result: %v", panels[1].X_axis.Tic_labels)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: candlesticks with whisker bars and colours", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("date open low high close\n20261019 10 9 13 12\n20261020 12 10 12.5 11\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		mockPlotFile := strings.NewReader("set style candlesticks falling rgb \"blue\"\n" +
+			`plot "` + tmpDataFile.Name() + `" using 1:2:3:4:5 with candlesticks whiskerbars title "XYZ"`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got := plot.(*Plot_2D)
+		want := []Point_2d{newFinancialPoint(20261019, 10, 9, 13, 12), newFinancialPoint(20261020, 12, 10, 12.5, 11)}
+		//	check the result
+		if !reflect.DeepEqual(want, got.Set_points[0].Point) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got.Set_points[0].Point)
+		}
+		if got.Set_points[0].Style != CANDLESTICKS || got.Set_points[0].Whisker_bars != 1 || got.Set_points[0].Title != "XYZ" {
+			t.Errorf("failed parsing plot file: expected candlesticks with whisker bars result: %v", got.Set_points[0])
+		}
+		if got.Financial.Rising != nil || got.Financial.Falling == nil || *got.Financial.Falling != BLUE {
+			t.Errorf("failed parsing plot file: expected blue falling colour result: %v", got.Financial)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: financebars without the close column", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("1 10 9 13 12\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		want := "invalid number of columns for style financebars: 1:2:3:4"
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:2:3:4 with financebars`)
+		_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: boxplot split by a factor column", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("latency release\n10 v1\n20 v2\n12 v1\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		mockPlotFile := strings.NewReader("set style boxplot range 3 nooutliers\n" +
+			`plot "` + tmpDataFile.Name() + `" using (1):1:(0.4):2 with boxplot`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got := plot.(*Plot_2D)
+		want := []Point_2d{{X: 1, Y: 10}, {X: 2, Y: 20}, {X: 1, Y: 12}}
+		//	check the result
+		if !reflect.DeepEqual(want, got.Set_points[0].Point) || got.Set_points[0].boxWidth != 0.4 {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got.Set_points[0].Point)
+		}
+
+		wantLabels := []Tic_label{{Label: "v1", Value: 1}, {Label: "v2", Value: 2}}
+		if !reflect.DeepEqual(wantLabels, got.X_axis.Tic_labels) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", wantLabels, got.X_axis.Tic_labels)
+		}
+		if got.Boxplot.Range != 3 || !got.Boxplot.No_outliers {
+			t.Errorf("failed parsing plot file: expected boxplot range 3 without outliers result: %v", got.Boxplot)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: invalid boxplot range", func(t *testing.T) {
+		want := "invalid boxplot range: 0"
+
+		mockPlotFile := strings.NewReader("set style boxplot range 0\nplot x")
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: vectors from four columns", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("x y dx dy\n1 2 0.5 -1\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:2:3:4 with vectors nohead title "flow"`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err != nil {
+			t.Errorf("fail loading plot file: %s", err.Error())
+			return
+		}
+
+		got := plot.(*Plot_2D).Set_points[0]
+		want := newVectorPoint(1, 2, 0.5, -1)
+		//	check the result
+		if !reflect.DeepEqual(want, got.Point[0]) {
+			t.Errorf("failed parsing plot file: expected: %v result: %v", want, got.Point[0])
+		}
+		if got.Style != VECTORS || got.Vector.Head != ARROW_NOHEAD || got.Title != "flow" {
+			t.Errorf("failed parsing plot file: expected vectors without heads result: %v", got)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: circles coloured by the palette", func(t *testing.T) {
 
 		//	create a temporary data file
 		tmpDataFile, err := os.CreateTemp("", "goPlotData")
@@ -350,7 +1650,7 @@ func TestLoadPlotFile(t *testing.T) {
 		}
 		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		_, err = tmpDataFile.Write([]byte("x y r load\n1 2 0.5 80\n3 4 0.25 20\n"))
 		if err != nil {
 			tmpDataFile.Close()
 			t.Errorf("fail writing to plot data file: %s", err.Error())
@@ -362,84 +1662,89 @@ func TestLoadPlotFile(t *testing.T) {
 			return
 		}
 
-		expectedSetPoints := 1
-		expectedPoints := 2
-		expectedStyle := "boxes"
-
-		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" with ` + expectedStyle)
+		mockPlotFile := strings.NewReader(`set palette defined (0 "blue", 100 "red")` + "\n" +
+			`set cbrange [0:100]` + "\n" +
+			`unset colorbox` + "\n" +
+			`plot "` + tmpDataFile.Name() + `" using 1:2:3:4 with circles lc palette z title "load"`)
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		plot_2d := plot.(*Plot_2D)
+		got := plot_2d.Set_points[0]
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if got.Style != CIRCLES || got.Line_style.Colour_source != COLOUR_PALETTE {
+			t.Errorf("failed parsing plot file: expected circles coloured by the palette result: %v", got)
 			return
 		}
-
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		if got.Point[1].Size == nil || *got.Point[1].Size != 0.25 || got.Point[1].Colour == nil || *got.Point[1].Colour != 20 {
+			t.Errorf("failed parsing plot file: expected radius 0.25 and colour 20 result: %v", got.Point[1])
 		}
-
-		want = int(Style[expectedStyle])
-		got = int(plot.(*Plot_2D).Set_points[0].Style)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+		if len(plot_2d.Palette.Defined) != 2 || plot_2d.Cb_axis.Max == nil || *plot_2d.Cb_axis.Max != 100 || !plot_2d.No_colour_box {
+			t.Errorf("failed parsing plot file: expected palette, colour range and no colour box result: %v", plot_2d)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: with option without a plot command", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: variable point size and colour", func(t *testing.T) {
 
-		mockPlotFile := strings.NewReader(`with boxes`)
-		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
-		if err == nil {
-			t.Errorf("error expected loading plot file")
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
 			return
 		}
+		defer os.Remove(tmpDataFile.Name())
 
-		want := "'with' option without a plot command: with boxes"
-		got := err
-		//	check the result
-		if want != got.Error() {
-			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		_, err = tmpDataFile.Write([]byte("x y size colour\n1 2 1.5 3\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
 		}
-	})
-
-	t.Run(">>> LoadPlotFile: plot \"file\" with slopes", func(t *testing.T) {
 
-		//	create a temporary data file
-		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:2:3:4 with points ps variable lc variable`)
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail creating plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		defer os.Remove(tmpDataFile.Name())
 
-		want := "invalid style: slopes"
+		got := plot.(*Plot_2D).Set_points[0]
+		//	check the result
+		if got.Point[0].Size == nil || *got.Point[0].Size != 1.5 || got.Point[0].Colour == nil || *got.Point[0].Colour != 3 {
+			t.Errorf("failed parsing plot file: expected size 1.5 and colour 3 result: %v", got.Point[0])
+		}
+		if got.Title != tmpDataFile.Name()+" u 1:2" {
+			t.Errorf("failed parsing plot file: expected default title without the variable columns result: %s", got.Title)
+		}
+	})
 
-		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" with slopes`)
-		_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+	t.Run(">>> LoadPlotFile: missing variable columns", func(t *testing.T) {
+
+		want := "missing columns for variable size or colour: 1"
+
+		mockPlotFile := strings.NewReader(`plot "data.dat" using 1 with points lc palette`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err == nil {
 			t.Errorf("error expected loading plot file")
 			return
 		}
 
-		got := err
+		got := err.Error()
 		//	check the result
-		if want != got.Error() {
+		if want != got {
 			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" title \"description\"", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: matrix with image", func(t *testing.T) {
 
 		//	create a temporary data file
 		tmpDataFile, err := os.CreateTemp("", "goPlotData")
@@ -449,7 +1754,7 @@ func TestLoadPlotFile(t *testing.T) {
 		}
 		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		_, err = tmpDataFile.Write([]byte("# matrix\n1 2 3\n4 5 6\n"))
 		if err != nil {
 			tmpDataFile.Close()
 			t.Errorf("fail writing to plot data file: %s", err.Error())
@@ -461,170 +1766,158 @@ func TestLoadPlotFile(t *testing.T) {
 			return
 		}
 
-		expectedSetPoints := 1
-		expectedPoints := 2
-		expectedTitle := "annual inflation"
-
-		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" title "` + expectedTitle + `"`)
+		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" matrix with image`)
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		got := plot.(*Plot_2D).Set_points[0]
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if got.Style != IMAGE || len(got.Point) != 6 || got.Title != tmpDataFile.Name()+" matrix" {
+			t.Errorf("failed parsing plot file: expected an image of 6 points result: %v", got)
 			return
 		}
-
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
-		}
-
-		wantString := expectedTitle
-		gotString := plot.(*Plot_2D).Set_points[0].Title
-		//	check the result
-		if wantString != gotString {
-			t.Errorf("failed parsing plot file: expected: %s result: %s", wantString, gotString)
+		if got.Point[5].X != 2 || got.Point[5].Y != 1 || got.Point[5].Colour == nil || *got.Point[5].Colour != 6 {
+			t.Errorf("failed parsing plot file: expected value 6 at 2,1 result: %v", got.Point[5])
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: title option without a plot command", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: matrix with using option", func(t *testing.T) {
 
-		mockPlotFile := strings.NewReader(`title "outside a plot"`)
-		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
-		if err == nil {
-			t.Errorf("error expected loading plot file")
-			return
-		}
+		want := "using option is not supported for matrix data: data.dat"
 
-		want := `'title' option without a plot command: title "outside a plot"`
-		got := err
-		//	check the result
-		if want != got.Error() {
-			t.Errorf("failed parsing plot file: expected error: %s result: %s", want, got)
+		mockPlotFile := strings.NewReader(`plot "data.dat" matrix using 1:2 with image`)
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" using 1:3 with boxes (multiple options)", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: function of x and y with contours", func(t *testing.T) {
 
-		//	create a temporary data file
-		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		mockPlotFile := strings.NewReader("set isosamples 20, 30\n" +
+			"set cntrparam levels discrete 1, 4\n" +
+			"unset cntrlabel\n" +
+			"plot [-2:2] [-1:3] x*x + y*y with contours")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail creating plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
-		if err != nil {
-			tmpDataFile.Close()
-			t.Errorf("fail writing to plot data file: %s", err.Error())
+		got := plot.(*Plot_2D)
+		//	check the result
+		if got.Iso_samples_x != 20 || got.Iso_samples_y != 30 || !got.Contour.No_labels || !reflect.DeepEqual(got.Contour.Levels.Values, []float64{1, 4}) {
+			t.Errorf("failed parsing plot file: expected isosamples 20,30 and discrete levels result: %v", got)
 			return
 		}
-		err = tmpDataFile.Close()
-		if err != nil {
-			t.Errorf("fail closing the plot data file: %s", err.Error())
-			return
+		if got.Function[0].Style != CONTOURS || got.Function[0].Min_x != -2 || got.Function[0].Max_x != 2 || got.Function[0].Min_y != -1 || got.Function[0].Max_y != 3 {
+			t.Errorf("failed parsing plot file: expected contours in [-2:2] [-1:3] result: %v", got.Function[0])
 		}
+	})
 
-		expectedSetPoints := 1
-		expectedPoints := 2
-		expectedStyle := "boxes"
+	t.Run(">>> LoadPlotFile: set samples", func(t *testing.T) {
 
-		mockPlotFile := strings.NewReader(`plot "` + tmpDataFile.Name() + `" using 1:3 with ` + expectedStyle)
+		mockPlotFile := strings.NewReader("set samples 50\nplot tan(x)")
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		got := plot.(*Plot_2D)
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if got.Samples != 50 {
+			t.Errorf("failed parsing plot file: expected 50 samples result: %d", got.Samples)
 			return
 		}
 
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		want := []string{"samples expected to be at least 2: set samples 1", "a single number of samples expected: set samples 100, 100"}
+
+		for i, line := range []string{"set samples 1", "set samples 100, 100"} {
+			mockPlotFile = strings.NewReader(line + "\nplot tan(x)")
+			_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+			if err == nil || want[i] != err.Error() {
+				t.Errorf("failed parsing plot file: expected error: %s result: %v", want[i], err)
+			}
 		}
+	})
 
-		want = int(Style[expectedStyle])
-		got = int(plot.(*Plot_2D).Set_points[0].Style)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+	t.Run(">>> LoadPlotFile: contours in parametric mode", func(t *testing.T) {
+
+		want := "contours require a function of x and y: cos(t)"
+
+		mockPlotFile := strings.NewReader("set parametric\nplot cos(t), sin(t) with contours")
+		_, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" using 1:3 with boxes (options in multiple lines)", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: implicit relation", func(t *testing.T) {
 
-		//	create a temporary data file
-		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		mockPlotFile := strings.NewReader("plot [-2:2] [-1:1] x**2 + y**2 = 1 title \"circle\" with lines, sin(x)")
+		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
-			t.Errorf("fail creating plot data file: %s", err.Error())
+			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
-		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
-		if err != nil {
-			tmpDataFile.Close()
-			t.Errorf("fail writing to plot data file: %s", err.Error())
+		got := plot.(*Plot_2D)
+		//	check the result
+		if len(got.Function) != 2 || !got.Function[0].Implicit || got.Function[1].Implicit {
+			t.Errorf("failed parsing plot file: expected an implicit relation and a function result: %v", got.Function)
 			return
 		}
-		err = tmpDataFile.Close()
-		if err != nil {
-			t.Errorf("fail closing the plot data file: %s", err.Error())
-			return
+		if got.Function[0].Function != "x**2 + y**2 = 1" || got.Function[0].Title != "circle" || got.Function[0].Min_y != -1 || got.Function[0].Max_y != 1 {
+			t.Errorf("failed parsing plot file: expected the relation in [-2:2] [-1:1] result: %v", got.Function[0])
 		}
 
-		expectedSetPoints := 1
-		expectedPoints := 2
-		expectedStyle := "boxes"
+		want := "implicit relations require a function of x and y: r = 1"
 
-		mockPlotFile := strings.NewReader("plot \"" + tmpDataFile.Name() + "\"\nusing 1:3\nwith " + expectedStyle)
+		mockPlotFile = strings.NewReader("set polar\nplot r = 1")
+		_, err = LoadPlotFile(bufio.NewReader(mockPlotFile))
+		if err == nil || want != err.Error() {
+			t.Errorf("failed parsing plot file: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> LoadPlotFile: splot a function of x and y", func(t *testing.T) {
+
+		mockPlotFile := strings.NewReader("set view 45, 120, 0.8\n" +
+			"set zrange [-1:1]\n" +
+			"set zlabel \"height\"\n" +
+			"set hidden3d\n" +
+			"set isosamples 25\n" +
+			"splot [-3:3] [-2:2] sin(x)*cos(y) with lines, x*y with pm3d")
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
+		got, ok := plot.(*Plot_3D)
 		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
+		if !ok {
+			t.Errorf("failed parsing plot file: expected a 3D plot result: %v", plot)
 			return
 		}
-
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
+		if !reflect.DeepEqual(*got.View, View{Rot_x: 45, Rot_z: 120, Scale: 0.8}) || !got.Hidden3d || got.Z_label != "height" || got.Iso_samples_x != 25 {
+			t.Errorf("failed parsing plot file: expected view 45, 120, 0.8 and hidden lines result: %v", got)
+			return
 		}
-
-		want = int(Style[expectedStyle])
-		got = int(plot.(*Plot_2D).Set_points[0].Style)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
+		if got.Z_axis.Min == nil || *got.Z_axis.Min != -1 || got.Z_axis.Max == nil || *got.Z_axis.Max != 1 {
+			t.Errorf("failed parsing plot file: expected z range [-1:1] result: %v", got.Z_axis)
+			return
+		}
+		if len(got.Function) != 2 || got.Function[0].Min_y != -2 || got.Function[1].Style != PM3D || got.Function[1].order != 2 {
+			t.Errorf("failed parsing plot file: expected two functions result: %v", got.Function)
 		}
 	})
 
-	t.Run(">>> LoadPlotFile: plot \"file\" using 1:3 with boxes (followed by a global command)", func(t *testing.T) {
+	t.Run(">>> LoadPlotFile: splot a data file", func(t *testing.T) {
 
 		//	create a temporary data file
 		tmpDataFile, err := os.CreateTemp("", "goPlotData")
@@ -634,7 +1927,7 @@ func TestLoadPlotFile(t *testing.T) {
 		}
 		defer os.Remove(tmpDataFile.Name())
 
-		_, err = tmpDataFile.Write([]byte("col1 col2 col3\n10 20 30\n40 50 60\n"))
+		_, err = tmpDataFile.Write([]byte("x y z\n0 0 1\n1 0 2\n0 1 3\n1 1 4\n"))
 		if err != nil {
 			tmpDataFile.Close()
 			t.Errorf("fail writing to plot data file: %s", err.Error())
@@ -646,46 +1939,34 @@ func TestLoadPlotFile(t *testing.T) {
 			return
 		}
 
-		expectedSetPoints := 1
-		expectedPoints := 2
-		expectedStyle := "boxes"
-		expectedTerminal := "canvas"
-
-		mockPlotFile := strings.NewReader("plot \"" + tmpDataFile.Name() + "\"\nusing 1:3\nwith " + expectedStyle + "\n" +
-			"set terminal " + expectedTerminal)
+		mockPlotFile := strings.NewReader(`splot "` + tmpDataFile.Name() + `" using 2:1:3 with linespoints title "grid"`)
 		plot, err := LoadPlotFile(bufio.NewReader(mockPlotFile))
 		if err != nil {
 			t.Errorf("fail loading plot file: %s", err.Error())
 			return
 		}
 
-		want := expectedSetPoints
-		got := len(plot.(*Plot_2D).Set_points)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d sets result: %d", want, got)
-			return
-		}
-
-		want = expectedPoints
-		got = len(plot.(*Plot_2D).Set_points[0].Point)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d points result: %d", want, got)
-		}
-
-		want = int(Style[expectedStyle])
-		got = int(plot.(*Plot_2D).Set_points[0].Style)
-		//	check the result
-		if want != got {
-			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", want, expectedStyle, got)
-		}
-
-		wantString := expectedTerminal
-		gotString := plot.(*Plot_2D).Terminal
+		got := plot.(*Plot_3D)
 		//	check the result
-		if terminal[wantString] != gotString {
-			t.Errorf("failed parsing plot file: expected: %d (%s) result: %d", terminal[wantString], wantString, gotString)
+		want := []Point_3d{{X: 0, Y: 0, Z: 1}, {X: 0, Y: 1, Z: 2}, {X: 1, Y: 0, Z: 3}, {X: 1, Y: 1, Z: 4}}
+		if len(got.Set_points) != 1 || got.Set_points[0].Title != "grid" || got.Set_points[0].Style != LINES_POINTS || !reflect.DeepEqual(want, got.Set_points[0].Point) {
+			t.Errorf("failed parsing plot file: expected the points of the data file result: %v", got.Set_points)
+		}
+
+		for _, test := range []struct {
+			plotFile string
+			want     string
+		}{
+			{plotFile: `splot "` + tmpDataFile.Name() + `" using 1:2`, want: "splot requires the x, y and z columns: 1:2"},
+			{plotFile: `splot "` + tmpDataFile.Name() + `" with boxes`, want: "invalid style for splot: boxes"},
+			{plotFile: "plot sin(x)\nsplot x*y", want: "plot and splot commands in the same plot file"},
+			{plotFile: "set multiplot layout 1, 2\nsplot x*y", want: "splot command is not supported in multiplot mode"},
+			{plotFile: "set view 200, 30", want: "view rotation around x expected to be from 0 to 180"},
+		} {
+			_, err = LoadPlotFile(bufio.NewReader(strings.NewReader(test.plotFile)))
+			if err == nil || test.want != err.Error() {
+				t.Errorf("failed parsing plot file: expected error: %s result: %v", test.want, err)
+			}
 		}
 	})
 
@@ -818,9 +2099,9 @@ func TestNewFunction2D(t *testing.T) {
 
 	t.Run(">>> newFunction2D: invalid style", func(t *testing.T) {
 
-		want := `invalid style: circles`
+		want := `invalid style: bubbles`
 
-		_, got := newFunction2D("sin(x)", "-10", "+10", "circles", "")
+		_, got := newFunction2D("sin(x)", "-10", "+10", "bubbles", "")
 		//	check the result
 		if want != got.Error() {
 			t.Errorf("failed creating a new function 2D: expected error: %s result: %s", want, got)
@@ -935,9 +2216,9 @@ func TestNewSetPoints2D(t *testing.T) {
 			return
 		}
 
-		want := `invalid style: circles`
+		want := `invalid style: bubbles`
 
-		_, got := newSetPoints2D(tmpDataFile.Name(), "1", "2", "circles", "")
+		_, got := newSetPoints2D(tmpDataFile.Name(), "1", "2", "bubbles", "")
 		//	check the result
 		if want != got.Error() {
 			t.Errorf("failed creating a new set of points: expected error: %s result: %s", want, got)
@@ -1053,4 +2334,47 @@ func TestNewSetPoints2D(t *testing.T) {
 			t.Errorf("failed creating a new set of points: expected: %s result: %s", wantString, gotString)
 		}
 	})
+
+	t.Run(">>> newSetPoints2D: error bars with low and high columns", func(t *testing.T) {
+
+		//	create a temporary data file
+		tmpDataFile, err := os.CreateTemp("", "goPlotData")
+		if err != nil {
+			t.Errorf("fail creating plot data file: %s", err.Error())
+			return
+		}
+		defer os.Remove(tmpDataFile.Name())
+
+		_, err = tmpDataFile.Write([]byte("x y low high\n1 20 18 23\n2 25 24 26\n"))
+		if err != nil {
+			tmpDataFile.Close()
+			t.Errorf("fail writing to plot data file: %s", err.Error())
+			return
+		}
+		err = tmpDataFile.Close()
+		if err != nil {
+			t.Errorf("fail closing the plot data file: %s", err.Error())
+			return
+		}
+
+		setOfPoints, err := newSetPoints2D(tmpDataFile.Name(), "1", "2", "yerrorbars", "", "3", "4")
+		if err != nil {
+			t.Errorf("fail creating a new set of points: %s", err.Error())
+			return
+		}
+
+		want := Point_2d{X: 1, Y: 20, Error: &Error_2d{X_low: 1, X_high: 1, Y_low: 18, Y_high: 23}}
+		got := setOfPoints.Point[0]
+		//	check the result
+		if !reflect.DeepEqual(want, got) || setOfPoints.Title != tmpDataFile.Name()+" u 1:2:3:4" {
+			t.Errorf("failed creating a new set of points: expected: %v result: %v %v", want, got, *got.Error)
+		}
+
+		wantError := "invalid number of columns for style xyerrorbars: 1:2:3"
+		_, err = newSetPoints2D(tmpDataFile.Name(), "1", "2", "xyerrorbars", "", "3")
+		//	check the result
+		if err == nil || wantError != err.Error() {
+			t.Errorf("failed creating a new set of points: expected error: %s result: %v", wantError, err)
+		}
+	})
 }
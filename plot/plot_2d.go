@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"os"
 
 	"github.com/aldebap/go-plot/expression"
 )
@@ -23,12 +24,34 @@ const (
 
 //	styles for a plot of points
 const (
-	BOXES         uint8 = 1
-	DOTS          uint8 = 2
-	LINES         uint8 = 3
-	LINES_POINTS  uint8 = 4
-	POINTS        uint8 = 5
-	FUNCTION_PATH uint8 = 6
+	BOXES          uint8 = 1
+	DOTS           uint8 = 2
+	LINES          uint8 = 3
+	LINES_POINTS   uint8 = 4
+	POINTS         uint8 = 5
+	FUNCTION_PATH  uint8 = 6
+	Y_ERROR_BARS   uint8 = 7
+	X_ERROR_BARS   uint8 = 8
+	XY_ERROR_BARS  uint8 = 9
+	Y_ERROR_LINES  uint8 = 10
+	BOX_ERROR_BARS uint8 = 11
+	FILLED_CURVES  uint8 = 12
+	HISTOGRAMS     uint8 = 13
+	CANDLESTICKS   uint8 = 14
+	FINANCEBARS    uint8 = 15
+	IMPULSES       uint8 = 16
+	STEPS          uint8 = 17
+	FSTEPS         uint8 = 18
+	FILLSTEPS      uint8 = 19
+	HISTEPS        uint8 = 20
+	BOXPLOT        uint8 = 21
+	VIOLIN         uint8 = 22
+	VECTORS        uint8 = 23
+	CIRCLES        uint8 = 24
+	IMAGE          uint8 = 25
+	CONTOURS       uint8 = 26
+	PM3D           uint8 = 27
+	IMPLICIT       uint8 = 28
 )
 
 const (
@@ -46,6 +69,7 @@ const (
 //	colour pallete for plots
 var (
 	BLACK = RGB_colour{red: 0, green: 0, blue: 0}
+	WHITE = RGB_colour{red: 255, green: 255, blue: 255}
 	RED   = RGB_colour{red: 255, green: 0, blue: 0}
 	GREEN = RGB_colour{red: 0, green: 255, blue: 0}
 	BLUE  = RGB_colour{red: 0, green: 0, blue: 255}
@@ -61,36 +85,109 @@ var (
 type Point_2d struct {
 	X float64
 	Y float64
+
+	//	error extents used by the error bar styles
+	Error *Error_2d
+
+	//	other limit of a filled area, or the tip of a vector
+	X_to *float64
+	Y_to *float64
+
+	//	size and colour values given by variable columns
+	Size   *float64
+	Colour *float64
 }
 
 //	2D points list
 type Set_points_2d struct {
-	Title string
-	Style uint8
-	Point []Point_2d
-	order uint8
+	Title         string
+	Style         uint8
+	Line_style    Line_style
+	Fill          Fill_style
+	Filled_curves Filled_curves
+	Whisker_bars  float64
+	Vector        Vector_style
+	Axes          uint8
+	Point         []Point_2d
+	order         uint8
+	barSize       float64
+	boxWidth      float64
+	rowColours    bool
+	financial     Financial_style
+	boxplot       Boxplot_style
+	colours       *colourMap
+	contour       Contour_style
+	paths         []Contour_path
 }
 
 //	2D function
 type Function_2d struct {
-	Title    string
-	Style    uint8
+	Title         string
+	Style         uint8
+	Line_style    Line_style
+	Fill          Fill_style
+	Filled_curves Filled_curves
+	Axes          uint8
+
+	//	f(x), x(t) of a parametric function, r(t) of a polar one, f(x,y) of a contour or f(x,y) = g(x,y) of an implicit relation
 	Function string
 	Min_x    float64
 	Max_x    float64
-	order    uint8
+
+	//	range of y where contours and implicit relations are sampled
+	Min_y float64
+	Max_y float64
+
+	//	the Function is a relation traced as the curves where both sides are equal
+	Implicit bool
+
+	//	the Function is x(t) and Function_y is y(t)
+	Parametric bool
+	Function_y string
+
+	//	range of t of parametric and polar functions
+	Min_t float64
+	Max_t float64
+
+	order uint8
 }
 
 //	attributes used to describe a 2D plot
 type Plot_2D struct {
-	X_label    string
-	Y_label    string
-	Set_points []Set_points_2d
-	Function   []Function_2d
-	Width      int64
-	Height     int64
-	Terminal   uint8
-	output     string
+	X_label          string
+	Y_label          string
+	X2_label         string
+	Y2_label         string
+	X_axis           Axis
+	Y_axis           Axis
+	X2_axis          Axis
+	Y2_axis          Axis
+	Cb_axis          Axis
+	Grid             Grid
+	Bar_size         *float64
+	Histogram        Histogram_style
+	Financial        Financial_style
+	Boxplot          Boxplot_style
+	Palette          Palette
+	No_colour_box    bool
+	Contour          Contour_style
+	Iso_samples_x    int
+	Iso_samples_y    int
+	Samples          int
+	Polar            bool
+	Angles           uint8
+	Annotations      []Annotation
+	X_zero_axis      *Line_style
+	Y_zero_axis      *Line_style
+	Reference_lines  []Reference_line
+	Reference_bands  []Reference_band
+	Set_points       []Set_points_2d
+	Function         []Function_2d
+	Width            int64
+	Height           int64
+	Terminal         uint8
+	Terminal_options TerminalOptions
+	output           string
 }
 
 //	GetOutputFileName return the plot's output file name
@@ -102,28 +199,14 @@ func (p *Plot_2D) GetOutputFileName() string {
 func (p *Plot_2D) GeneratePlot(plotWriter *bufio.Writer) error {
 
 	//	create the graphics driver
-	var driver GraphicsDriver
-
-	switch p.Terminal {
-	case TERMINAL_CANVAS:
-		driver = NewCanvas_Driver(plotWriter)
-
-	case TERMINAL_GIF:
-		driver = NewGIF_Driver(plotWriter)
-
-	case TERMINAL_JPEG:
-		driver = NewJPEG_Driver(plotWriter)
-
-	case TERMINAL_PNG:
-		driver = NewPNG_Driver(plotWriter)
+	driver := newGraphicsDriver(p.Terminal, &p.Terminal_options, plotWriter)
+	defer driver.Close()
 
-	case TERMINAL_SVG:
-		driver = NewSVG_Driver(plotWriter)
+	return p.generate(driver)
+}
 
-	default:
-		driver = NewSVG_Driver(plotWriter)
-	}
-	defer driver.Close()
+//	generate implementation of 2D Go_Plot generation in a graphics driver
+func (p *Plot_2D) generate(driver GraphicsDriver) error {
 
 	//	check if there's a plot to be generated
 	if len(p.Set_points) == 0 && len(p.Function) == 0 {
@@ -157,6 +240,26 @@ func (p *Plot_2D) GeneratePlot(plotWriter *bufio.Writer) error {
 
 			fmt.Printf("[debug] parsing function #%d: %s\n", function.order, function.Function)
 
+			//	the curves of implicit relations are traced from their samples
+			if function.Implicit {
+				path, err := ImplicitPaths(function.Function, p.Angles, function.Min_x, function.Max_x, function.Min_y, function.Max_y)
+				if err != nil {
+					return err
+				}
+
+				//	the corners of the interval where the relation is sampled give the range of the plot
+				function_points[i] = Set_points_2d{
+					Title:      function.Title,
+					Style:      IMPLICIT,
+					Line_style: function.Line_style,
+					Axes:       function.Axes,
+					Point:      []Point_2d{{X: function.Min_x, Y: function.Min_y}, {X: function.Max_x, Y: function.Max_y}},
+					order:      function.order,
+					paths:      path,
+				}
+				continue
+			}
+
 			functionExpr, err := expression.NewExpression(function.Function)
 			if err != nil {
 				return errors.New("error parsing function to be plotted: " + err.Error())
@@ -166,101 +269,224 @@ func (p *Plot_2D) GeneratePlot(plotWriter *bufio.Writer) error {
 			symbolTable := expression.NewFloatSymbolTable()
 
 			expression.AddStandardMathFuncs(symbolTable)
+			expression.SetAngleUnit(symbolTable, p.Angles)
 
-			function_points[i].Point = make([]Point_2d, width-2*int64(X_MARGINS)+1)
 			function_points[i].Style = FUNCTION_PATH
+			if function.Style == FILLED_CURVES {
+				function_points[i].Style = FILLED_CURVES
+				function_points[i].Fill = function.Fill
+				function_points[i].Filled_curves = function.Filled_curves
+			}
 			function_points[i].Title = function.Title
+			function_points[i].Line_style = function.Line_style
+			function_points[i].order = function.order
+			function_points[i].Axes = function.Axes
 
-			for j := 0; j < len(function_points[i].Point); j++ {
-				symbolTable.SetValue("x", function.Min_x+float64(j)*(function.Max_x-function.Min_x)/(float64(width)-2*X_MARGINS))
+			//	functions of x and y plotted as contours are sampled in a grid
+			if function.Style == CONTOURS {
+				columns, rows := isoSamples(p.Iso_samples_x, p.Iso_samples_y)
 
-				function_points[i].Point[j].X, err = symbolTable.GetValue("x")
-				function_points[i].Point[j].Y, err = functionExpr.Evaluate(symbolTable)
+				function_points[i].Style = CONTOURS
+				function_points[i].Point, err = sampleFunctionGrid(function.Function, p.Angles, function.Min_x, function.Max_x, function.Min_y, function.Max_y, columns, rows)
 				if err != nil {
-					return errors.New("error evaluating function to be plotted: " + err.Error())
+					return err
 				}
+				continue
 			}
-		}
-	}
 
-	//	evaluate the data's dimension
-	var min_x, min_y, max_x, max_y float64
-	var err error
+			//	the curves are sampled for evenly spaced values of the parameter, one for each pixel column unless the number
+			//	of samples is informed, and refined where they bend
+			samples := p.Samples
+			if samples <= 0 {
+				samples = int(width-2*int64(X_MARGINS)) + 1
+			}
 
-	if len(p.Set_points) > 0 {
+			var curve func(u float64) (Point_2d, error)
 
-		min_x, min_y, max_x, max_y, err = p.Set_points[0].getMinMax()
-		if err != nil {
-			return errors.New("error evaluating the min-max of set to be plotted: " + err.Error())
-		}
-	} else {
+			switch {
+			case function.Parametric:
+				//	parametric functions are evaluated for values of t
+				functionYExpr, err := expression.NewExpression(function.Function_y)
+				if err != nil {
+					return errors.New("error parsing function to be plotted: " + err.Error())
+				}
 
-		min_x, min_y, max_x, max_y, err = function_points[0].getMinMax()
-		if err != nil {
-			return errors.New("error evaluating the min-max of function to be plotted: " + err.Error())
+				curve = func(u float64) (Point_2d, error) {
+					var point Point_2d
+					var err error
+
+					symbolTable.SetValue("t", function.Min_t+(function.Max_t-function.Min_t)*u)
+
+					point.X, err = functionExpr.Evaluate(symbolTable)
+					if err != nil {
+						return point, errors.New("error evaluating function to be plotted: " + err.Error())
+					}
+					point.Y, err = functionYExpr.Evaluate(symbolTable)
+					if err != nil {
+						return point, errors.New("error evaluating function to be plotted: " + err.Error())
+					}
+
+					return point, nil
+				}
+
+			case p.Polar:
+				//	polar functions give the radius for angles t
+				factor := angleFactor(p.Angles)
+
+				curve = func(u float64) (Point_2d, error) {
+					t := function.Min_t + (function.Max_t-function.Min_t)*u
+					symbolTable.SetValue("t", t)
+
+					r, err := functionExpr.Evaluate(symbolTable)
+					if err != nil {
+						return Point_2d{}, errors.New("error evaluating function to be plotted: " + err.Error())
+					}
+
+					return Point_2d{X: r * math.Cos(t*factor), Y: r * math.Sin(t*factor)}, nil
+				}
+
+			default:
+				//	in a logarithmic x axis the samples are evenly spaced in the transformed interval
+				x_axis, _ := p.seriesAxes(function.Axes)
+				sampleScale := newAxisScale(x_axis, function.Min_x, function.Max_x, 0, 1)
+
+				if !x_axis.valid(function.Min_x) || !x_axis.valid(function.Max_x) {
+					return errors.New("function interval must be positive in a logarithmic x axis: " + function.Function)
+				}
+
+				curve = func(u float64) (Point_2d, error) {
+					x := sampleScale.value(u)
+					symbolTable.SetValue("x", x)
+
+					y, err := functionExpr.Evaluate(symbolTable)
+					if err != nil {
+						return Point_2d{}, errors.New("error evaluating function to be plotted: " + err.Error())
+					}
+
+					return Point_2d{X: x, Y: y}, nil
+				}
+			}
+
+			function_points[i].Point, err = samplePath(samples, curve)
+			if err != nil {
+				return err
+			}
+
+			//	the area under a curve is filled only where it's defined
+			if function_points[i].Style == FILLED_CURVES {
+				function_points[i].Point = finitePoints(function_points[i].Point)
+			}
 		}
 	}
 
-	for _, pointsSet := range p.Set_points {
-		var set_min_x, set_min_y, set_max_x, set_max_y float64
+	//	skip the points that cannot be represented in logarithmic axes (in polar mode points are angle and radius, and
+	//	histograms are placed by their layout)
+	set_points := make([]Set_points_2d, len(p.Set_points))
 
-		set_min_x, set_min_y, set_max_x, set_max_y, err := pointsSet.getMinMax()
-		if err != nil {
-			return errors.New("error evaluating the min-max of set to be plotted: " + err.Error())
+	for i := range p.Set_points {
+		set_points[i] = p.Set_points[i]
+		if p.Polar {
+			set_points[i] = set_points[i].polarToCartesian(p.Angles)
 		}
+	}
+	p.layoutHistograms(set_points)
 
-		if set_min_x < min_x {
-			min_x = set_min_x
-		}
-		if set_min_y < min_y {
-			min_y = set_min_y
-		}
-		if set_max_x > max_x {
-			max_x = set_max_x
-		}
-		if set_max_y > max_y {
-			max_y = set_max_y
-		}
+	for i := range set_points {
+		x_axis, y_axis := p.seriesAxes(set_points[i].Axes)
+		set_points[i] = set_points[i].filterLogScale(x_axis, y_axis)
+	}
+	for i := range function_points {
+		x_axis, y_axis := p.seriesAxes(function_points[i].Axes)
+		function_points[i] = function_points[i].filterLogScale(x_axis, y_axis)
 	}
 
-	//	evaluate the function's dimension
-	for _, pointsSet := range function_points {
-		var set_min_x, set_min_y, set_max_x, set_max_y float64
+	//	evaluate the dimension of the data and functions in each axis
+	var x_range, y_range, x2_range, y2_range axisRange
+	var err error
 
-		set_min_x, set_min_y, set_max_x, set_max_y, err := pointsSet.getMinMax()
+	extendRanges := func(pointsSet *Set_points_2d) error {
+		min_x, min_y, max_x, max_y, err := pointsSet.getMinMax()
 		if err != nil {
-			return errors.New("error evaluating the min-max of function to be plotted: " + err.Error())
+			return err
 		}
 
-		if set_min_x < min_x {
-			min_x = set_min_x
+		if pointsSet.Axes == AXES_X2Y1 || pointsSet.Axes == AXES_X2Y2 {
+			x2_range.extend(min_x, max_x)
+		} else {
+			x_range.extend(min_x, max_x)
 		}
-		if set_min_y < min_y {
-			min_y = set_min_y
+		if pointsSet.Axes == AXES_X1Y2 || pointsSet.Axes == AXES_X2Y2 {
+			y2_range.extend(min_y, max_y)
+		} else {
+			y_range.extend(min_y, max_y)
 		}
-		if set_max_x > max_x {
-			max_x = set_max_x
+
+		return nil
+	}
+
+	for i := range set_points {
+		err := extendRanges(&set_points[i])
+		if err != nil {
+			return errors.New("error evaluating the min-max of set to be plotted: " + err.Error())
 		}
-		if set_max_y > max_y {
-			max_y = set_max_y
+	}
+	for i := range function_points {
+		err := extendRanges(&function_points[i])
+		if err != nil {
+			return errors.New("error evaluating the min-max of function to be plotted: " + err.Error())
 		}
 	}
 
-	//	round the scale to multiples of 10 when all plots are based on data sets
-	if len(p.Function) == 0 {
-		min_x = math.Floor(min_x) - float64(int64(math.Floor(min_x))%10)
-		min_y = math.Floor(min_y) - float64(int64(math.Floor(min_y))%10)
+	//	secondary axes are drawn on their own when used by a series or configured
+	x2_active := x2_range.used || p.X2_axis.configured() || len(p.X2_label) > 0
+	y2_active := y2_range.used || p.Y2_axis.configured() || len(p.Y2_label) > 0
+
+	//	an axis without series shares the range of it's counterpart
+	if !x_range.used {
+		x_range = x2_range
+	}
+	if !x2_range.used {
+		x2_range = x_range
+	}
+	if !y_range.used {
+		y_range = y2_range
+	}
+	if !y2_range.used {
+		y2_range = y_range
+	}
 
-		max_x = math.Ceil(max_x)
-		if int64(max_x)%10 > 0 {
-			max_x += float64(10 - int64(max_x)%10)
+	//	round the scale to the axes' major ticks when all plots are based on data sets (images fill the plot)
+	rounded := len(p.Function) == 0
+	for i := range set_points {
+		if set_points[i].Style == IMAGE {
+			rounded = false
 		}
-		max_y = math.Ceil(max_y)
-		if int64(max_y)%10 > 0 {
-			max_y += float64(10 - int64(max_y)%10)
+	}
+
+	if rounded {
+		x_range.min, x_range.max = p.X_axis.roundRange(x_range.min, x_range.max, MIN_X_SCALE_DIVISIONS)
+		y_range.min, y_range.max = p.Y_axis.roundRange(y_range.min, y_range.max, MIN_Y_SCALE_DIVISIONS)
+		x2_range.min, x2_range.max = p.X2_axis.roundRange(x2_range.min, x2_range.max, MIN_X_SCALE_DIVISIONS)
+		y2_range.min, y2_range.max = p.Y2_axis.roundRange(y2_range.min, y2_range.max, MIN_Y_SCALE_DIVISIONS)
+	}
+
+	//	fixed ranges replace the autoscaled ones
+	for _, item := range []struct {
+		axis       *Axis
+		axis_range *axisRange
+	}{{&p.X_axis, &x_range}, {&p.Y_axis, &y_range}, {&p.X2_axis, &x2_range}, {&p.Y2_axis, &y2_range}} {
+		item.axis_range.min, item.axis_range.max, err = item.axis.limits(item.axis_range.min, item.axis_range.max)
+		if err != nil {
+			return err
 		}
 	}
 
+	//	the values mapped into the palette give the range of the colour axis
+	colours, err := p.colourMap(set_points, function_points)
+	if err != nil {
+		return err
+	}
+
 	//	set the graphics dimension
 	err = driver.SetDimensions(width, height)
 	if err != nil {
@@ -275,8 +501,48 @@ func (p *Plot_2D) GeneratePlot(plotWriter *bufio.Writer) error {
 		return errors.New("error setting plot font: " + err.Error())
 	}
 
-	//	generate the plot grid
-	p.generatePlotGrid(driver, min_x, min_y, max_x, max_y)
+	//	the colour box takes the right side of the graphic and the plot is generated in the remaining area
+	if colours != nil && !p.No_colour_box {
+		colours.generateColourBox(driver, width, height)
+
+		width -= COLOUR_BOX_AREA
+		driver = NewViewport_Driver(driver, 0, 0, width, height)
+
+		plotArea := *p
+		plotArea.Width = width
+		p = &plotArea
+	}
+
+	//	create the transformations from the axes into driver coordinates
+	x_scale := newAxisScale(&p.X_axis, x_range.min, x_range.max, X_MARGINS, float64(width)-2*X_MARGINS)
+	y_scale := newAxisScale(&p.Y_axis, y_range.min, y_range.max, Y_MARGINS, float64(height)-2*Y_MARGINS)
+
+	//	inactive secondary axes mirror the primary ones
+	x2_scale := x_scale
+	if x2_active {
+		x2_scale = newAxisScale(&p.X2_axis, x2_range.min, x2_range.max, X_MARGINS, float64(width)-2*X_MARGINS)
+	}
+	y2_scale := y_scale
+	if y2_active {
+		y2_scale = newAxisScale(&p.Y2_axis, y2_range.min, y2_range.max, Y_MARGINS, float64(height)-2*Y_MARGINS)
+	}
+
+	//	generate the shaded bands and the grid lines behind every other element of the plot
+	p.generateReferenceBands(driver, x_scale, y_scale)
+	p.generatePlotGrid(driver, x_scale, y_scale)
+
+	//	generate the images behind the plot border, keeping it's ticks visible
+	for _, pointsSet := range set_points {
+		if pointsSet.Style == IMAGE {
+			series_x_scale, series_y_scale := seriesScales(pointsSet.Axes, x_scale, y_scale, x2_scale, y2_scale)
+
+			pointsSet.colours = colours
+			pointsSet.generateImage(driver, series_x_scale, series_y_scale)
+		}
+	}
+
+	//	generate the plot border and scales
+	p.generatePlotBorder(driver, x_scale, y_scale, x2_scale, y2_scale)
 
 	//	add the X & Y titles
 	if len(p.X_label) > 0 {
@@ -289,24 +555,98 @@ func (p *Plot_2D) GeneratePlot(plotWriter *bufio.Writer) error {
 
 		driver.Text(int64(X_MARGINS)-2*SCALE_WIDTH-textHeight, int64(Y_MARGINS)+height/2-textWidth, -90, p.Y_label, BLACK)
 	}
+	if len(p.X2_label) > 0 {
+		textWidth, _ := driver.GetTextBox(p.X2_label)
+
+		driver.Text(int64(X_MARGINS)+width/2-textWidth/2, height-int64(Y_MARGINS)+2*SCALE_WIDTH, 0, p.X2_label, BLACK)
+	}
+	if len(p.Y2_label) > 0 {
+		textWidth, textHeight := driver.GetTextBox(p.Y2_label)
+
+		driver.Text(width-int64(X_MARGINS)+2*SCALE_WIDTH+textHeight, int64(Y_MARGINS)+height/2-textWidth, -90, p.Y2_label, BLACK)
+	}
+
+	//	generate the annotations drawn behind the data
+	err = p.generateAnnotations(driver, x_scale, y_scale, x2_scale, y2_scale, false)
+	if err != nil {
+		return errors.New("error generating annotations: " + err.Error())
+	}
+
+	//	generate the zero axes and reference lines behind the data
+	p.generateReferenceLines(driver, x_scale, y_scale)
+
+	//	sets and functions created without a plot file are shown in the legend in their sequence
+	var legendOrder uint8
+
+	for i := range set_points {
+		if set_points[i].order == 0 {
+			set_points[i].order = uint8(i + 1)
+		}
+		if set_points[i].order > legendOrder {
+			legendOrder = set_points[i].order
+		}
+	}
+	for i := range function_points {
+		if function_points[i].order == 0 {
+			function_points[i].order = uint8(len(set_points) + i + 1)
+		}
+		if function_points[i].order > legendOrder {
+			legendOrder = function_points[i].order
+		}
+	}
 
 	//	generate the plot for every set of points
-	for i, pointsSet := range p.Set_points {
-		pointsSet.generatePlot(driver, width, height, min_x, min_y, max_x, max_y, plotPallete[i%len(plotPallete)])
+	for i, pointsSet := range set_points {
+		series_x_scale, series_y_scale := seriesScales(pointsSet.Axes, x_scale, y_scale, x2_scale, y2_scale)
+
+		pointsSet.barSize = p.barSize()
+		pointsSet.financial = p.Financial
+		pointsSet.boxplot = p.Boxplot
+		pointsSet.colours = colours
+		pointsSet.contour = p.Contour
+		pointsSet.generatePlot(driver, width, height, series_x_scale, series_y_scale, pointsSet.Line_style.colour(i), pointsSet.Line_style.pointType(i))
 	}
 
 	//	generate the plot for every function
 	for i, pointsSet := range function_points {
-		pointsSet.generatePlot(driver, width, height, min_x, min_y, max_x, max_y, plotPallete[i%len(plotPallete)])
+		series_x_scale, series_y_scale := seriesScales(pointsSet.Axes, x_scale, y_scale, x2_scale, y2_scale)
+
+		pointsSet.colours = colours
+		pointsSet.contour = p.Contour
+		pointsSet.generatePlot(driver, width, height, series_x_scale, series_y_scale, pointsSet.Line_style.colour(i), pointsSet.Line_style.pointType(i))
+	}
+
+	//	reference lines and bands are shown in the legend after the data
+	p.generateReferenceLegend(driver, width, height, legendOrder)
+
+	//	generate the annotations drawn in front of the data
+	err = p.generateAnnotations(driver, x_scale, y_scale, x2_scale, y2_scale, true)
+	if err != nil {
+		return errors.New("error generating annotations: " + err.Error())
 	}
 
 	return nil
 }
 
-//	generatePlotGrid implementation of 2D Go_Plot grid generation
-func (p *Plot_2D) generatePlotGrid(driver GraphicsDriver, min_x, min_y, max_x, max_y float64) {
+//	seriesAxes return the pair of axes used by a series
+func (p *Plot_2D) seriesAxes(axes uint8) (*Axis, *Axis) {
 
-	fmt.Printf("[debug] min (%f, %f) max (%f, %f)\n", min_x, min_y, max_x, max_y)
+	x_axis, y_axis := &p.X_axis, &p.Y_axis
+
+	if axes == AXES_X2Y1 || axes == AXES_X2Y2 {
+		x_axis = &p.X2_axis
+	}
+	if axes == AXES_X1Y2 || axes == AXES_X2Y2 {
+		y_axis = &p.Y2_axis
+	}
+
+	return x_axis, y_axis
+}
+
+//	generatePlotBorder implementation of 2D Go_Plot border and scales generation
+func (p *Plot_2D) generatePlotBorder(driver GraphicsDriver, x_scale, y_scale, x2_scale, y2_scale *axisScale) {
+
+	fmt.Printf("[debug] min (%f, %f) max (%f, %f)\n", x_scale.min, y_scale.min, x_scale.max, y_scale.max)
 
 	//	get plot dimention from driver's default or from plot parameters when present
 	width, height := driver.GetDimensions()
@@ -318,8 +658,8 @@ func (p *Plot_2D) generatePlotGrid(driver GraphicsDriver, min_x, min_y, max_x, m
 		height = p.Height
 	}
 
-	//	add the plot grid
-	driver.Comment("plot grid")
+	//	add the plot border
+	driver.Comment("plot border")
 
 	driver.Line(int64(X_MARGINS), int64(Y_MARGINS),
 		width-int64(X_MARGINS), int64(Y_MARGINS), BLACK)
@@ -331,78 +671,140 @@ func (p *Plot_2D) generatePlotGrid(driver GraphicsDriver, min_x, min_y, max_x, m
 	driver.Line(width-int64(X_MARGINS), int64(Y_MARGINS),
 		width-int64(X_MARGINS), height-int64(Y_MARGINS), BLACK)
 
-	//	add the X scale in the plot grid
-	driver.Comment("grid x scale")
+	//	add the X scale in the plot border
+	driver.Comment("border x scale")
+
+	for _, tick := range x_scale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS) {
+		scaled_x := int64(x_scale.scale(tick.value))
+		tickWidth := int64(SCALE_WIDTH)
+
+		if tick.minor {
+			tickWidth /= 2
+		}
 
-	xScaleDivisions := int64(max_x-min_x) / 10
+		driver.Line(scaled_x, int64(Y_MARGINS), scaled_x, int64(Y_MARGINS)+tickWidth, BLACK)
 
-	if xScaleDivisions < MIN_X_SCALE_DIVISIONS {
-		xScaleDivisions = MIN_X_SCALE_DIVISIONS
-	} else {
-		if xScaleDivisions > MAX_X_SCALE_DIVISIONS {
-			xScaleDivisions = MAX_X_SCALE_DIVISIONS
+		//	without a secondary axis, the ticks are mirrored in the opposite border
+		if x2_scale == x_scale {
+			driver.Line(scaled_x, height-int64(Y_MARGINS), scaled_x, height-int64(Y_MARGINS)-tickWidth, BLACK)
+		}
+
+		if len(tick.label) > 0 {
+			textWidth, textHeight := driver.GetTextBox(tick.label)
+
+			driver.Text(scaled_x-textWidth/2, int64(Y_MARGINS)-SCALE_WIDTH-textHeight, 0, tick.label, BLACK)
 		}
 	}
 
-	for i := int64(0); i <= int64(xScaleDivisions); i++ {
-		x := min_x + float64(i*int64(max_x-min_x)/xScaleDivisions)
-		scaled_x := int64((float64(width) - 2*X_MARGINS) * (x - min_x) / (max_x - min_x))
+	//	add the X2 scale in the plot border
+	if x2_scale != x_scale {
+		driver.Comment("border x2 scale")
 
-		driver.Line(int64(X_MARGINS)+scaled_x, int64(Y_MARGINS),
-			int64(X_MARGINS)+scaled_x, int64(Y_MARGINS)+SCALE_WIDTH, BLACK)
-		driver.Line(int64(X_MARGINS)+scaled_x, height-int64(Y_MARGINS),
-			int64(X_MARGINS)+scaled_x, height-int64(Y_MARGINS)-SCALE_WIDTH, BLACK)
+		for _, tick := range x2_scale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS) {
+			scaled_x := int64(x2_scale.scale(tick.value))
+			tickWidth := int64(SCALE_WIDTH)
 
-		scaleNumber := fmt.Sprintf("%d", int64(x))
-		textWidth, textHeight := driver.GetTextBox(scaleNumber)
+			if tick.minor {
+				tickWidth /= 2
+			}
 
-		driver.Text(int64(X_MARGINS)+scaled_x-textWidth/2, int64(Y_MARGINS)-SCALE_WIDTH-textHeight, 0, scaleNumber, BLACK)
+			driver.Line(scaled_x, height-int64(Y_MARGINS), scaled_x, height-int64(Y_MARGINS)-tickWidth, BLACK)
+
+			if len(tick.label) > 0 {
+				textWidth, _ := driver.GetTextBox(tick.label)
+
+				driver.Text(scaled_x-textWidth/2, height-int64(Y_MARGINS)+SCALE_WIDTH, 0, tick.label, BLACK)
+			}
+		}
 	}
 
-	//	TODO: there's a bug here !
-	//	add the Y scale in the plot grid
-	driver.Comment("grid y scale")
+	//	add the Y scale in the plot border
+	driver.Comment("border y scale")
 
-	yScaleDivisions := int64(max_y-min_y) / 10
+	for _, tick := range y_scale.ticks(MIN_Y_SCALE_DIVISIONS, MAX_Y_SCALE_DIVISIONS) {
+		scaled_y := int64(y_scale.scale(tick.value))
+		tickWidth := int64(SCALE_WIDTH)
 
-	if yScaleDivisions < MIN_Y_SCALE_DIVISIONS {
-		yScaleDivisions = MIN_Y_SCALE_DIVISIONS
-	} else {
-		if yScaleDivisions > MAX_Y_SCALE_DIVISIONS {
-			yScaleDivisions = MAX_Y_SCALE_DIVISIONS
+		if tick.minor {
+			tickWidth /= 2
+		}
+
+		driver.Line(int64(X_MARGINS), scaled_y, int64(X_MARGINS)+tickWidth, scaled_y, BLACK)
+
+		//	without a secondary axis, the ticks are mirrored in the opposite border
+		if y2_scale == y_scale {
+			driver.Line(width-int64(X_MARGINS), scaled_y, width-int64(X_MARGINS)-tickWidth, scaled_y, BLACK)
+		}
+
+		if len(tick.label) > 0 {
+			textWidth, textHeight := driver.GetTextBox(tick.label)
+
+			driver.Text(int64(X_MARGINS)-SCALE_WIDTH-textWidth, scaled_y-textHeight/2, 0, tick.label, BLACK)
 		}
 	}
 
-	for i := int64(0); i <= int64(yScaleDivisions); i++ {
-		y := min_y + float64(i*int64(max_y-min_y)/yScaleDivisions)
-		scaled_y := int64((float64(height) - 2*Y_MARGINS) * (y - min_y) / (max_y - min_y))
+	//	add the Y2 scale in the plot border
+	if y2_scale != y_scale {
+		driver.Comment("border y2 scale")
+
+		for _, tick := range y2_scale.ticks(MIN_Y_SCALE_DIVISIONS, MAX_Y_SCALE_DIVISIONS) {
+			scaled_y := int64(y2_scale.scale(tick.value))
+			tickWidth := int64(SCALE_WIDTH)
+
+			if tick.minor {
+				tickWidth /= 2
+			}
 
-		driver.Line(int64(X_MARGINS), int64(Y_MARGINS)+scaled_y,
-			int64(X_MARGINS)+SCALE_WIDTH, int64(Y_MARGINS)+scaled_y, BLACK)
-		driver.Line(width-int64(X_MARGINS), int64(Y_MARGINS)+scaled_y,
-			width-int64(X_MARGINS)-SCALE_WIDTH, int64(Y_MARGINS)+scaled_y, BLACK)
+			driver.Line(width-int64(X_MARGINS), scaled_y, width-int64(X_MARGINS)-tickWidth, scaled_y, BLACK)
 
-		scaleNumber := fmt.Sprintf("%d", int64(y))
-		textWidth, textHeight := driver.GetTextBox(scaleNumber)
+			if len(tick.label) > 0 {
+				_, textHeight := driver.GetTextBox(tick.label)
 
-		driver.Text(int64(X_MARGINS)-SCALE_WIDTH-textWidth, int64(Y_MARGINS)+scaled_y-textHeight/2, 0, scaleNumber, BLACK)
+				driver.Text(width-int64(X_MARGINS)+SCALE_WIDTH, scaled_y-textHeight/2, 0, tick.label, BLACK)
+			}
+		}
 	}
 }
 
+//	generateLegendEntry show the title of an element of the plot next to a sample of it's style
+func generateLegendEntry(driver GraphicsDriver, plotWidth, plotHeight int64, order uint8, title string, drawSample func(x1, x2, y int64)) {
+
+	textWidth, textHeight := driver.GetTextBox(title)
+
+	//	the sample is vertically centered in the text
+	text_y := plotHeight - int64(Y_MARGINS) - int64(order)*(TITLE_MARGIN+textHeight)
+
+	drawSample(plotWidth-int64(X_MARGINS)-TITLE_MARGIN-COLOUR_TITLE_WIDTH, plotWidth-int64(X_MARGINS)-TITLE_MARGIN, text_y+textHeight/2)
+
+	driver.Text(plotWidth-int64(X_MARGINS)-2*TITLE_MARGIN-COLOUR_TITLE_WIDTH-textWidth, text_y, 0, title, BLACK)
+}
+
 //	getMinMax get the min-max X & Y values for the points in the set
 func (set *Set_points_2d) getMinMax() (min_x, min_y, max_x, max_y float64, err error) {
 
-	if len(set.Point) == 0 {
+	//	the points that are not finite (the breaks of the paths of functions) are not in the dimension
+	first := -1
+	for i, point := range set.Point {
+		if isFinite(point.X) && isFinite(point.Y) {
+			first = i
+			break
+		}
+	}
+	if first == -1 {
 		return 0, 0, 0, 0, errors.New("no points in the set")
 	}
 
 	//	evaluate the plot's dimension
-	min_x = set.Point[0].X
+	min_x = set.Point[first].X
 	max_x = min_x
-	min_y = set.Point[0].Y
+	min_y = set.Point[first].Y
 	max_y = min_y
 
 	for _, point := range set.Point {
+		if !isFinite(point.X) || !isFinite(point.Y) {
+			continue
+		}
+
 		if point.X < min_x {
 			min_x = point.X
 		}
@@ -416,10 +818,92 @@ func (set *Set_points_2d) getMinMax() (min_x, min_y, max_x, max_y float64, err e
 		if point.Y > max_y {
 			max_y = point.Y
 		}
+
+		//	the other limit of a filled area, the tip of a vector and the error extents are included in the dimension
+		if point.X_to != nil {
+			if *point.X_to < min_x {
+				min_x = *point.X_to
+			}
+			if *point.X_to > max_x {
+				max_x = *point.X_to
+			}
+		}
+		if point.Y_to != nil {
+			if *point.Y_to < min_y {
+				min_y = *point.Y_to
+			}
+			if *point.Y_to > max_y {
+				max_y = *point.Y_to
+			}
+		}
+		if point.Error != nil {
+			if point.Error.X_low < min_x {
+				min_x = point.Error.X_low
+			}
+			if point.Error.X_high > max_x {
+				max_x = point.Error.X_high
+			}
+			if point.Error.Y_low < min_y {
+				min_y = point.Error.Y_low
+			}
+			if point.Error.Y_high > max_y {
+				max_y = point.Error.Y_high
+			}
+		}
+	}
+
+	//	the boxes of histograms are in the dimension
+	if set.Style == HISTOGRAMS {
+		min_x -= set.boxWidth / 2
+		max_x += set.boxWidth / 2
+	}
+
+	//	the boxes and ticks of candlesticks and financebars are in the dimension
+	if set.Style == CANDLESTICKS || set.Style == FINANCEBARS {
+		min_x -= set.financialWidth() / 2
+		max_x += set.financialWidth() / 2
+	}
+
+	//	the boxes and violins of the samples are in the dimension, with a margin of a box on each side
+	if set.Style == BOXPLOT || set.Style == VIOLIN {
+		min_x -= set.width()
+		max_x += set.width()
+	}
+
+	//	the cells of images are in the dimension
+	if set.Style == IMAGE {
+		min_x, max_x, min_y, max_y = set.imageBounds()
+	}
+
+	//	the circles are in the dimension of the x axis, where their radius is given
+	if set.Style == CIRCLES {
+		for _, point := range set.Point {
+			if point.Size == nil {
+				continue
+			}
+			if point.X-*point.Size < min_x {
+				min_x = point.X - *point.Size
+			}
+			if point.X+*point.Size > max_x {
+				max_x = point.X + *point.Size
+			}
+		}
+	}
+
+	//	the steps centered in the first and last points are in the dimension
+	if set.Style == HISTEPS {
+		path := set.stepPath()
+
+		if path[0].X < min_x {
+			min_x = path[0].X
+		}
+		if path[len(path)-1].X > max_x {
+			max_x = path[len(path)-1].X
+		}
 	}
 
 	//	when the style is "boxes", add left and right margins
-	if set.Style == BOXES {
+	if set.Style == BOXES || set.Style == BOX_ERROR_BARS {
 		var meanXInterval float64
 
 		for i, _ := range set.Point {
@@ -430,6 +914,11 @@ func (set *Set_points_2d) getMinMax() (min_x, min_y, max_x, max_y float64, err e
 		}
 		meanXInterval /= float64(len(set.Point) - 1)
 
+		//	a single box has no interval to the next one, so it is one unit wide
+		if len(set.Point) == 1 {
+			meanXInterval = 1
+		}
+
 		min_x -= 3 * meanXInterval
 		max_x += 3 * meanXInterval
 	}
@@ -437,8 +926,58 @@ func (set *Set_points_2d) getMinMax() (min_x, min_y, max_x, max_y float64, err e
 	return min_x, min_y, max_x, max_y, nil
 }
 
+//	filterLogScale return a copy of the set without the points that cannot be represented in logarithmic axes (the
+//	paths of functions are split where these points were)
+func (set *Set_points_2d) filterLogScale(x_axis, y_axis *Axis) Set_points_2d {
+
+	if !x_axis.Log_scale && !y_axis.Log_scale {
+		return *set
+	}
+
+	filteredSet := *set
+	filteredSet.Point = make([]Point_2d, 0, len(set.Point))
+	skipped := 0
+
+	for _, point := range set.Point {
+		//	the breaks of a path are kept as they are
+		if !isFinite(point.X) || !isFinite(point.Y) {
+			filteredSet.Point = append(filteredSet.Point, point)
+			continue
+		}
+
+		if x_axis.valid(point.X) && y_axis.valid(point.Y) && (point.X_to == nil || x_axis.valid(*point.X_to)) && (point.Y_to == nil || y_axis.valid(*point.Y_to)) {
+			//	the error extents are limited to the positive part of the axes
+			if point.Error != nil {
+				point_error := *point.Error
+
+				if !x_axis.valid(point_error.X_low) {
+					point_error.X_low = point.X
+				}
+				if !y_axis.valid(point_error.Y_low) {
+					point_error.Y_low = point.Y
+				}
+				point.Error = &point_error
+			}
+
+			filteredSet.Point = append(filteredSet.Point, point)
+			continue
+		}
+
+		skipped++
+		if set.Style == FUNCTION_PATH {
+			filteredSet.Point = append(filteredSet.Point, pathBreak())
+		}
+	}
+
+	if skipped > 0 {
+		fmt.Fprintf(os.Stderr, "[warning] %d non positive points skipped in logarithmic scale: %s\n", skipped, set.Title)
+	}
+
+	return filteredSet
+}
+
 //	GeneratePlot generate the graphic for the points in the set
-func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHeight int64, min_x, min_y, max_x, max_y float64, colour RGB_colour) error {
+func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHeight int64, x_scale, y_scale *axisScale, colour RGB_colour, pointType uint8) error {
 
 	if len(set.Point) == 0 {
 		return errors.New("no points in the set")
@@ -446,58 +985,91 @@ func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHei
 
 	driver.Comment("plotting " + set.Title)
 
+	//	draw the set with it's line style and restore the default one for the next elements
+	lineWidth := set.Line_style.lineWidth()
+	pointWidth := POINT_WIDTH * set.Line_style.pointSize()
+
+	err := driver.SetLineStyle(lineWidth, set.Line_style.dashType())
+	if err != nil {
+		return err
+	}
+	defer driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+
 	switch set.Style {
 	case BOXES:
-		//	get the mean interval between consecutive pairs of x points
-		var meanXInterval float64
+		set.generateBoxes(driver, x_scale, y_scale, colour)
 
-		for i, _ := range set.Point {
-			if i == 0 {
-				continue
-			}
-			meanXInterval += set.Point[i].X - set.Point[i-1].X
+	case Y_ERROR_BARS, X_ERROR_BARS, XY_ERROR_BARS:
+		//	generate the error bars with a marker for each point (error bars are never dashed)
+		driver.SetLineStyle(lineWidth, DASH_SOLID)
+
+		set.generateErrorBars(driver, x_scale, y_scale, colour)
+		for _, point := range set.Point {
+			drawMarker(driver, x_scale.scale(point.X), y_scale.scale(point.Y), pointType, set.pointWidth(&point, pointWidth), set.pointColour(&point, colour))
 		}
-		meanXInterval /= float64(len(set.Point) - 1)
-		halfBoxWidth := meanXInterval / 2
 
-		//	generate an open box for each point
-		var scaled_x1, scaled_x2, scaled_y1, scaled_y2 float64
-		var previousScaled_y2 float64
+	case Y_ERROR_LINES:
+		//	generate a line connecting each point
+		if len(set.Point) > 1 {
+			driver.BeginPath(colour)
+			for _, point := range set.Point {
+				driver.PointToPath(int64(x_scale.scale(point.X)), int64(y_scale.scale(point.Y)))
+			}
+			driver.EndPath()
+		}
 
-		scaled_y1 = (float64(plotHeight) - 2*Y_MARGINS) * (0 - min_y) / (max_y - min_y)
+		//	generate the error bars with a marker for each point (error bars are never dashed)
+		driver.SetLineStyle(lineWidth, DASH_SOLID)
 
+		set.generateErrorBars(driver, x_scale, y_scale, colour)
 		for _, point := range set.Point {
+			drawMarker(driver, x_scale.scale(point.X), y_scale.scale(point.Y), pointType, set.pointWidth(&point, pointWidth), set.pointColour(&point, colour))
+		}
 
-			scaled_x1 = (float64(plotWidth) - 2*X_MARGINS) * (point.X - halfBoxWidth - min_x) / (max_x - min_x)
-			scaled_x2 = (float64(plotWidth) - 2*X_MARGINS) * (point.X + halfBoxWidth - min_x) / (max_x - min_x)
-			scaled_y2 = (float64(plotHeight) - 2*Y_MARGINS) * (point.Y - min_y) / (max_y - min_y)
+	case BOX_ERROR_BARS:
+		set.generateBoxes(driver, x_scale, y_scale, colour)
 
-			if previousScaled_y2 <= scaled_y2 {
-				driver.Line(int64(X_MARGINS+scaled_x1), int64(Y_MARGINS+scaled_y1),
-					int64(X_MARGINS+scaled_x1), int64(Y_MARGINS+scaled_y2), colour)
-			} else {
-				driver.Line(int64(X_MARGINS+scaled_x1), int64(Y_MARGINS+scaled_y1),
-					int64(X_MARGINS+scaled_x1), int64(Y_MARGINS+previousScaled_y2), colour)
-			}
-			driver.Line(int64(X_MARGINS+scaled_x1), int64(Y_MARGINS+scaled_y2),
-				int64(X_MARGINS+scaled_x2), int64(Y_MARGINS+scaled_y2), colour)
-			driver.Line(int64(X_MARGINS+scaled_x2), int64(Y_MARGINS+scaled_y1),
-				int64(X_MARGINS+scaled_x2), int64(Y_MARGINS+scaled_y2), colour)
+		driver.SetLineStyle(lineWidth, DASH_SOLID)
+		set.generateErrorBars(driver, x_scale, y_scale, colour)
 
-			previousScaled_y2 = scaled_y2
-		}
+	case FILLED_CURVES:
+		set.generateFilledCurves(driver, x_scale, y_scale, colour)
+
+	case HISTOGRAMS:
+		set.generateHistogram(driver, x_scale, y_scale, colour)
+
+	case CANDLESTICKS, FINANCEBARS:
+		set.generateFinancial(driver, x_scale, y_scale)
 
-		//	close the last box
-		driver.Line(int64(X_MARGINS+scaled_x2), int64(Y_MARGINS+scaled_y1),
-			int64(X_MARGINS+scaled_x2), int64(Y_MARGINS+scaled_y2), colour)
+	case BOXPLOT, VIOLIN:
+		set.generateBoxplot(driver, x_scale, y_scale, colour, pointType, pointWidth)
+
+	case VECTORS:
+		set.generateVectors(driver, x_scale, y_scale, colour)
+
+	case CIRCLES:
+		set.generateCircles(driver, x_scale, y_scale, colour)
+
+	case IMAGE:
+		//	images are generated behind the plot border and the colour box is their legend
+		return nil
+
+	case CONTOURS:
+		set.generateContours(driver, x_scale, y_scale, colour)
+
+	case IMPLICIT:
+		set.generateImplicit(driver, x_scale, y_scale, colour)
+
+	case IMPULSES:
+		set.generateImpulses(driver, x_scale, y_scale, colour)
+
+	case STEPS, FSTEPS, FILLSTEPS, HISTEPS:
+		set.generateSteps(driver, x_scale, y_scale, colour)
 
 	case DOTS:
 		//	generate a single dot for each point
 		for _, point := range set.Point {
-			scaled_x := (float64(plotWidth) - 2*X_MARGINS) * (point.X - min_x) / (max_x - min_x)
-			scaled_y := (float64(plotHeight) - 2*Y_MARGINS) * (point.Y - min_y) / (max_y - min_y)
-
-			driver.Point(int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y), colour)
+			driver.Point(int64(x_scale.scale(point.X)), int64(y_scale.scale(point.Y)), set.pointColour(&point, colour))
 		}
 
 	case LINES:
@@ -505,8 +1077,8 @@ func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHei
 		var prev_scaled_x, prev_scaled_y float64
 
 		for i, point := range set.Point {
-			scaled_x := (float64(plotWidth) - 2*X_MARGINS) * (point.X - min_x) / (max_x - min_x)
-			scaled_y := (float64(plotHeight) - 2*Y_MARGINS) * (point.Y - min_y) / (max_y - min_y)
+			scaled_x := x_scale.scale(point.X)
+			scaled_y := y_scale.scale(point.Y)
 
 			//	in the first iteration, just save the current point
 			if i == 0 {
@@ -515,8 +1087,8 @@ func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHei
 				continue
 			}
 
-			driver.Line(int64(X_MARGINS+prev_scaled_x), int64(Y_MARGINS+prev_scaled_y),
-				int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y), colour)
+			driver.Line(int64(prev_scaled_x), int64(prev_scaled_y),
+				int64(scaled_x), int64(scaled_y), colour)
 
 			prev_scaled_x = scaled_x
 			prev_scaled_y = scaled_y
@@ -527,14 +1099,8 @@ func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHei
 		var prev_scaled_x, prev_scaled_y float64
 
 		for i, point := range set.Point {
-			scaled_x := (float64(plotWidth) - 2*X_MARGINS) * (point.X - min_x) / (max_x - min_x)
-			scaled_y := (float64(plotHeight) - 2*Y_MARGINS) * (point.Y - min_y) / (max_y - min_y)
-
-			//	generate a cross for each point
-			driver.Line(int64(X_MARGINS+scaled_x-POINT_WIDTH/2), int64(Y_MARGINS+scaled_y),
-				int64(X_MARGINS+scaled_x+POINT_WIDTH/2), int64(Y_MARGINS+scaled_y), colour)
-			driver.Line(int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y-POINT_WIDTH/2),
-				int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y+POINT_WIDTH/2), colour)
+			scaled_x := x_scale.scale(point.X)
+			scaled_y := y_scale.scale(point.Y)
 
 			//	in the first iteration, just save the current point
 			if i == 0 {
@@ -543,49 +1109,128 @@ func (set *Set_points_2d) generatePlot(driver GraphicsDriver, plotWidth, plotHei
 				continue
 			}
 
-			driver.Line(int64(X_MARGINS+prev_scaled_x), int64(Y_MARGINS+prev_scaled_y),
-				int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y), colour)
+			driver.Line(int64(prev_scaled_x), int64(prev_scaled_y),
+				int64(scaled_x), int64(scaled_y), colour)
 
 			prev_scaled_x = scaled_x
 			prev_scaled_y = scaled_y
 		}
 
-	case POINTS:
-		//	TODO: improve to use a different figure for distinct sets of points
-		//	generate a cross for each point
-		for _, point := range set.Point {
-			scaled_x := (float64(plotWidth) - 2*X_MARGINS) * (point.X - min_x) / (max_x - min_x)
-			scaled_y := (float64(plotHeight) - 2*Y_MARGINS) * (point.Y - min_y) / (max_y - min_y)
+		//	generate a marker for each point (points are never dashed)
+		driver.SetLineStyle(lineWidth, DASH_SOLID)
 
-			driver.Line(int64(X_MARGINS+scaled_x-POINT_WIDTH/2), int64(Y_MARGINS+scaled_y),
-				int64(X_MARGINS+scaled_x+POINT_WIDTH/2), int64(Y_MARGINS+scaled_y), colour)
-			driver.Line(int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y-POINT_WIDTH/2),
-				int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y+POINT_WIDTH/2), colour)
+		for _, point := range set.Point {
+			drawMarker(driver, x_scale.scale(point.X), y_scale.scale(point.Y), pointType, set.pointWidth(&point, pointWidth), set.pointColour(&point, colour))
 		}
 
-	case FUNCTION_PATH:
-		//	generate a path connecting each point
-		driver.BeginPath(colour)
+	case POINTS:
+		//	generate a marker for each point (points are never dashed)
+		driver.SetLineStyle(lineWidth, DASH_SOLID)
 
 		for _, point := range set.Point {
-			scaled_x := (float64(plotWidth) - 2*X_MARGINS) * (point.X - min_x) / (max_x - min_x)
-			scaled_y := (float64(plotHeight) - 2*Y_MARGINS) * (point.Y - min_y) / (max_y - min_y)
+			drawMarker(driver, x_scale.scale(point.X), y_scale.scale(point.Y), pointType, set.pointWidth(&point, pointWidth), set.pointColour(&point, colour))
+		}
+
+	case FUNCTION_PATH:
+		//	generate a path connecting each point, split where the function is not defined or jumps
+		for _, segment := range pathSegments(set.Point) {
+			if len(segment) < 2 {
+				continue
+			}
 
-			driver.PointToPath(int64(X_MARGINS+scaled_x), int64(Y_MARGINS+scaled_y))
+			driver.BeginPath(colour)
+			for _, point := range segment {
+				driver.PointToPath(int64(x_scale.scale(point.X)), int64(y_scale.scale(point.Y)))
+			}
+			driver.EndPath()
 		}
-		driver.EndPath()
 
 	default:
 	}
 
-	//	show the title
-	textWidth, textHeight := driver.GetTextBox(set.Title)
+	//	show the title with a sample of the line style
+	generateLegendEntry(driver, plotWidth, plotHeight, set.order, set.Title, func(x1, x2, y int64) {
+		if set.Style == CIRCLES {
+			circle := regularPolygon(float64(x1+x2)/2, float64(y), POINT_WIDTH/2, CIRCLE_SIDES, 0)
 
-	driver.Line(plotWidth-int64(X_MARGINS)-TITLE_MARGIN-COLOUR_TITLE_WIDTH, plotHeight-int64(Y_MARGINS)-int64(set.order)*(TITLE_MARGIN+textHeight/2),
-		plotWidth-int64(X_MARGINS)-TITLE_MARGIN, plotHeight-int64(Y_MARGINS)-int64(set.order)*(TITLE_MARGIN+textHeight/2), colour)
+			driver.FillPolygon(circle, colour, set.Fill)
+			driver.Polygon(circle, colour, false)
+			return
+		}
+		if set.Style == FILLED_CURVES || set.Style == HISTOGRAMS || set.Style == FILLSTEPS || set.Style == BOXPLOT || set.Style == VIOLIN {
+			swatch := []DriverPoint{{X: x1, Y: y - POINT_WIDTH/2}, {X: x2, Y: y - POINT_WIDTH/2}, {X: x2, Y: y + POINT_WIDTH/2}, {X: x1, Y: y + POINT_WIDTH/2}}
 
-	driver.Text(plotWidth-int64(X_MARGINS)-2*TITLE_MARGIN-COLOUR_TITLE_WIDTH-textWidth,
-		plotHeight-int64(Y_MARGINS)-int64(set.order)*(TITLE_MARGIN+textHeight), 0, set.Title, BLACK)
+			driver.FillPolygon(swatch, colour, set.Fill)
+			if set.Style != FILLED_CURVES {
+				driver.Polygon(swatch, colour, false)
+			}
+			return
+		}
+		if set.Style == CANDLESTICKS || set.Style == FINANCEBARS {
+			colour = set.financial.rising()
+		}
+		if set.Style != POINTS {
+			driver.SetLineStyle(lineWidth, set.Line_style.dashType())
+			driver.Line(x1, y, x2, y, colour)
+		}
+		if set.hasMarkers() {
+			driver.SetLineStyle(lineWidth, DASH_SOLID)
+			drawMarker(driver, float64(x1+x2)/2, float64(y), pointType, pointWidth, colour)
+		}
+	})
 
 	return nil
 }
+
+//	generateBoxes generate an open box for each point of the set
+func (set *Set_points_2d) generateBoxes(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	//	get the mean interval between consecutive pairs of x points
+	var meanXInterval float64
+
+	for i, _ := range set.Point {
+		if i == 0 {
+			continue
+		}
+		meanXInterval += set.Point[i].X - set.Point[i-1].X
+	}
+	meanXInterval /= float64(len(set.Point) - 1)
+
+	//	a single box has no interval to the next one, so it is one unit wide
+	if len(set.Point) == 1 {
+		meanXInterval = 1
+	}
+	halfBoxWidth := meanXInterval / 2
+
+	//	generate an open box for each point
+	var scaled_x1, scaled_x2, scaled_y1, scaled_y2 float64
+	var previousScaled_y2 float64
+
+	scaled_y1 = y_scale.scale(y_scale.origin())
+	previousScaled_y2 = scaled_y1
+
+	for _, point := range set.Point {
+
+		scaled_x1 = x_scale.scale(point.X - halfBoxWidth)
+		scaled_x2 = x_scale.scale(point.X + halfBoxWidth)
+		scaled_y2 = y_scale.scale(point.Y)
+
+		if previousScaled_y2 <= scaled_y2 {
+			driver.Line(int64(scaled_x1), int64(scaled_y1),
+				int64(scaled_x1), int64(scaled_y2), colour)
+		} else {
+			driver.Line(int64(scaled_x1), int64(scaled_y1),
+				int64(scaled_x1), int64(previousScaled_y2), colour)
+		}
+		driver.Line(int64(scaled_x1), int64(scaled_y2),
+			int64(scaled_x2), int64(scaled_y2), colour)
+		driver.Line(int64(scaled_x2), int64(scaled_y1),
+			int64(scaled_x2), int64(scaled_y2), colour)
+
+		previousScaled_y2 = scaled_y2
+	}
+
+	//	close the last box
+	driver.Line(int64(scaled_x2), int64(scaled_y1),
+		int64(scaled_x2), int64(scaled_y2), colour)
+}
@@ -6,7 +6,12 @@
 
 package plot
 
-import "testing"
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
 
 //	TestGeneratePlot unit tests for GeneratePlot()
 func TestGeneratePlot(t *testing.T) {
@@ -92,4 +97,48 @@ func TestGetMinMax(t *testing.T) {
 				got_min_x, got_min_y, got_max_x, got_max_y)
 		}
 	})
+
+	t.Run(">>> getMinMax: single box", func(t *testing.T) {
+
+		for _, style := range []uint8{BOXES, BOX_ERROR_BARS} {
+			testSet := &Set_points_2d{
+				Style: style,
+				Point: []Point_2d{{X: 1, Y: 5, Error: &Error_2d{X_low: 1, X_high: 1, Y_low: 4, Y_high: 6}}},
+			}
+
+			min_x, _, max_x, _, err := testSet.getMinMax()
+			//	check the result: the box is one unit wide, with the margins of three boxes
+			if err != nil || min_x != -2 || max_x != 4 {
+				t.Errorf("failed evaluatin MinMax: expected: -2, 4 result: %f, %f %v", min_x, max_x, err)
+			}
+		}
+	})
+
+	t.Run(">>> generate: single point with boxes", func(t *testing.T) {
+
+		for _, style := range []uint8{BOXES, BOX_ERROR_BARS} {
+			var output bytes.Buffer
+
+			writer := bufio.NewWriter(&output)
+			plot := &Plot_2D{
+				Set_points: []Set_points_2d{{
+					Title: "single box",
+					Style: style,
+					Point: []Point_2d{{X: 1, Y: 5, Error: &Error_2d{X_low: 1, X_high: 1, Y_low: 4, Y_high: 6}}},
+				}},
+			}
+
+			err := plot.generate(NewSVG_Driver(writer, &TerminalOptions{Width: 400, Height: 300}))
+			writer.Flush()
+			if err != nil {
+				t.Errorf("fail generating plot: %s", err.Error())
+				return
+			}
+
+			//	check the result
+			if strings.Contains(output.String(), "NaN") || strings.Contains(output.String(), "-9223372036854775808") {
+				t.Errorf("failed generating plot: expected a box with finite coordinates result: %s", output.String())
+			}
+		}
+	})
 }
@@ -0,0 +1,742 @@
+////////////////////////////////////////////////////////////////////////////////
+//	plot_3d.go  -  Oct-19-2026  -  aldebap
+//
+//	Generate a 3D Go-Plot, projected into the primitives of the graphics drivers
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	DEFAULT_ROT_X = 60
+	DEFAULT_ROT_Z = 30
+
+	MARGIN_3D = 60
+
+	MIN_3D_SCALE_DIVISIONS = 5
+	MAX_3D_SCALE_DIVISIONS = 10
+)
+
+//	style descriptions for a 3D plot
+var (
+	Style_3d = map[string]uint8{
+		"dots":        DOTS,
+		"lines":       LINES,
+		"linespoints": LINES_POINTS,
+		"points":      POINTS,
+		"pm3d":        PM3D,
+	}
+)
+
+//	3D point
+type Point_3d struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+//	set of 3D points (when the points make a grid, lines and pm3d styles draw a surface)
+type Set_points_3d struct {
+	Title      string
+	Style      uint8
+	Line_style Line_style
+	Point      []Point_3d
+	order      uint8
+}
+
+//	3D function of x and y, sampled in a grid from Min_x, Min_y to Max_x, Max_y (drawn as a mesh, or as a surface with
+//	the pm3d style)
+type Function_3d struct {
+	Title      string
+	Style      uint8
+	Line_style Line_style
+	Function   string
+	Min_x      float64
+	Max_x      float64
+	Min_y      float64
+	Max_y      float64
+	order      uint8
+}
+
+//	point of view of a 3D plot: rotation in degrees around the x axis (0 to 180) followed by the rotation around the
+//	z axis (0 to 360), and the scale of the projection
+type View struct {
+	Rot_x float64
+	Rot_z float64
+	Scale float64
+}
+
+//	attributes used to describe a 3D plot (gnuplot's view 60, 30 is used when the view is not informed)
+type Plot_3D struct {
+	X_label          string
+	Y_label          string
+	Z_label          string
+	X_axis           Axis
+	Y_axis           Axis
+	Z_axis           Axis
+	View             *View
+	Hidden3d         bool
+	Palette          Palette
+	No_colour_box    bool
+	Iso_samples_x    int
+	Iso_samples_y    int
+	Angles           uint8
+	Set_points       []Set_points_3d
+	Function         []Function_3d
+	Width            int64
+	Height           int64
+	Terminal         uint8
+	Terminal_options TerminalOptions
+	output           string
+}
+
+//	Validate check if the view angles and scale are in their ranges
+func (view *View) Validate() error {
+
+	if view.Rot_x < 0 || view.Rot_x > 180 {
+		return errors.New("view rotation around x expected to be from 0 to 180")
+	}
+	if view.Rot_z < 0 || view.Rot_z > 360 {
+		return errors.New("view rotation around z expected to be from 0 to 360")
+	}
+	if view.Scale <= 0 {
+		return errors.New("view scale expected to be positive")
+	}
+
+	return nil
+}
+
+//	GetOutputFileName return the plot's output file name
+func (p *Plot_3D) GetOutputFileName() string {
+	return p.output
+}
+
+//	GeneratePlot implementation of 3D Go_Plot generation
+func (p *Plot_3D) GeneratePlot(plotWriter *bufio.Writer) error {
+
+	//	create the graphics driver
+	driver := newGraphicsDriver(p.Terminal, &p.Terminal_options, plotWriter)
+	defer driver.Close()
+
+	return p.generate(driver)
+}
+
+//	transformation of the values of the axes into driver coordinates: the axes are mapped into a cube from -1 to 1,
+//	rotated by the view and scaled into the plot area
+type projection struct {
+	x_scale  *axisScale
+	y_scale  *axisScale
+	z_scale  *axisScale
+	cos_x    float64
+	sin_x    float64
+	cos_z    float64
+	sin_z    float64
+	factor   float64
+	centre_x float64
+	centre_y float64
+}
+
+//	newProjection create the projection of a view, fitting the cube of the axes into the plot area
+func newProjection(view *View, x_scale, y_scale, z_scale *axisScale, width, height int64) *projection {
+
+	rot_x := view.Rot_x * math.Pi / 180
+	rot_z := view.Rot_z * math.Pi / 180
+
+	proj := &projection{
+		x_scale:  x_scale,
+		y_scale:  y_scale,
+		z_scale:  z_scale,
+		cos_x:    math.Cos(rot_x),
+		sin_x:    math.Sin(rot_x),
+		cos_z:    math.Cos(rot_z),
+		sin_z:    math.Sin(rot_z),
+		centre_x: float64(width) / 2,
+		centre_y: float64(height) / 2,
+	}
+
+	var extent_h, extent_v float64
+
+	for _, corner := range [][3]float64{{-1, -1, -1}, {1, -1, -1}, {1, 1, -1}, {-1, 1, -1}, {-1, -1, 1}, {1, -1, 1}, {1, 1, 1}, {-1, 1, 1}} {
+		h, v, _ := proj.rotate(corner[0], corner[1], corner[2])
+
+		extent_h = math.Max(extent_h, math.Abs(h))
+		extent_v = math.Max(extent_v, math.Abs(v))
+	}
+
+	proj.factor = view.Scale * math.Min((float64(width)-2*MARGIN_3D)/(2*extent_h), (float64(height)-2*MARGIN_3D)/(2*extent_v))
+
+	return proj
+}
+
+//	rotate rotate a point of the cube around the z axis and then around the horizontal axis of the screen, returning
+//	it's horizontal and vertical coordinates and it's depth (larger ones are farther from the viewer)
+func (proj *projection) rotate(x, y, z float64) (float64, float64, float64) {
+
+	h := x*proj.cos_z + y*proj.sin_z
+	d := -x*proj.sin_z + y*proj.cos_z
+
+	return h, d*proj.cos_x + z*proj.sin_x, d*proj.sin_x - z*proj.cos_x
+}
+
+//	project map a 3D point into driver coordinates and it's depth
+func (proj *projection) project(x, y, z float64) (float64, float64, float64) {
+
+	h, v, depth := proj.rotate(proj.x_scale.scale(x), proj.y_scale.scale(y), proj.z_scale.scale(z))
+
+	return proj.centre_x + proj.factor*h, proj.centre_y + proj.factor*v, depth
+}
+
+//	point project a 3D point into a point of the driver
+func (proj *projection) point(x, y, z float64) DriverPoint {
+
+	scaled_x, scaled_y, _ := proj.project(x, y, z)
+
+	return DriverPoint{X: int64(scaled_x), Y: int64(scaled_y)}
+}
+
+//	contains check if a 3D point is inside the ranges of the axes
+func (proj *projection) contains(point *Point_3d) bool {
+	return proj.x_scale.contains(point.X) && proj.y_scale.contains(point.Y) && proj.z_scale.contains(point.Z)
+}
+
+//	generate implementation of 3D Go_Plot generation in a graphics driver
+func (p *Plot_3D) generate(driver GraphicsDriver) error {
+
+	//	check if there's a plot to be generated
+	if len(p.Set_points) == 0 && len(p.Function) == 0 {
+		return errors.New("no set of points or functions to be plotted")
+	}
+
+	view := View{Rot_x: DEFAULT_ROT_X, Rot_z: DEFAULT_ROT_Z, Scale: 1}
+	if p.View != nil {
+		view = *p.View
+	}
+
+	err := view.Validate()
+	if err != nil {
+		return err
+	}
+
+	//	get plot dimention from driver's default or from plot parameters when present
+	width, height := driver.GetDimensions()
+
+	if p.Width > 0 {
+		width = p.Width
+	}
+	if p.Height > 0 {
+		height = p.Height
+	}
+
+	//	functions are sampled in a grid of points, after the sets of points
+	set_points := append([]Set_points_3d(nil), p.Set_points...)
+
+	for _, function := range p.Function {
+
+		columns, rows := isoSamples(p.Iso_samples_x, p.Iso_samples_y)
+
+		sample, err := sampleFunctionGrid(function.Function, p.Angles, function.Min_x, function.Max_x, function.Min_y, function.Max_y, columns, rows)
+		if err != nil {
+			return err
+		}
+
+		set := Set_points_3d{
+			Title:      function.Title,
+			Style:      LINES,
+			Line_style: function.Line_style,
+			Point:      make([]Point_3d, len(sample)),
+			order:      function.order,
+		}
+		if function.Style == PM3D {
+			set.Style = PM3D
+		}
+
+		//	the function is not defined in the points without a value
+		for i, point := range sample {
+			set.Point[i] = Point_3d{X: point.X, Y: point.Y, Z: math.NaN()}
+			if point.Colour != nil {
+				set.Point[i].Z = *point.Colour
+			}
+		}
+
+		set_points = append(set_points, set)
+	}
+
+	//	evaluate the dimension of the data and functions in each axis
+	var x_range, y_range, z_range axisRange
+
+	for _, set := range set_points {
+		for _, point := range set.Point {
+			if math.IsNaN(point.Z) || math.IsInf(point.Z, 0) {
+				continue
+			}
+
+			x_range.extend(point.X, point.X)
+			y_range.extend(point.Y, point.Y)
+			z_range.extend(point.Z, point.Z)
+		}
+	}
+	if !z_range.used {
+		return errors.New("no points to be plotted")
+	}
+
+	//	the ranges of functions are their intervals and the others are rounded to the axes' major ticks
+	if len(p.Function) == 0 {
+		x_range.min, x_range.max = p.X_axis.roundRange(x_range.min, x_range.max, MIN_3D_SCALE_DIVISIONS)
+		y_range.min, y_range.max = p.Y_axis.roundRange(y_range.min, y_range.max, MIN_3D_SCALE_DIVISIONS)
+	}
+	z_range.min, z_range.max = p.Z_axis.roundRange(z_range.min, z_range.max, MIN_3D_SCALE_DIVISIONS)
+
+	//	fixed ranges replace the autoscaled ones
+	for _, item := range []struct {
+		axis       *Axis
+		axis_range *axisRange
+	}{{&p.X_axis, &x_range}, {&p.Y_axis, &y_range}, {&p.Z_axis, &z_range}} {
+		item.axis_range.min, item.axis_range.max, err = item.axis.limits(item.axis_range.min, item.axis_range.max)
+		if err != nil {
+			return err
+		}
+	}
+
+	//	set the graphics dimension
+	err = driver.SetDimensions(width, height)
+	if err != nil {
+		return errors.New("error setting plot dimentions: " + err.Error())
+	}
+
+	//	set the graphics font
+	fontFamily, fontSize := driver.GetFont()
+
+	err = driver.SetFont(fontFamily, fontSize)
+	if err != nil {
+		return errors.New("error setting plot font: " + err.Error())
+	}
+
+	//	surfaces are painted with the colour of the palette for their z, shown in the colour box at the right side
+	var colours *colourMap
+
+	for _, set := range set_points {
+		if set.Style == PM3D {
+			colours = &colourMap{
+				palette: &p.Palette,
+				scale:   newAxisScale(&p.Z_axis, z_range.min, z_range.max, 0, 1),
+			}
+		}
+	}
+
+	if colours != nil && !p.No_colour_box {
+		colours.generateColourBox(driver, width, height)
+
+		width -= COLOUR_BOX_AREA
+		driver = NewViewport_Driver(driver, 0, 0, width, height)
+	}
+
+	//	create the projection of the axes into driver coordinates
+	proj := newProjection(&view,
+		newAxisScale(&p.X_axis, x_range.min, x_range.max, -1, 2),
+		newAxisScale(&p.Y_axis, y_range.min, y_range.max, -1, 2),
+		newAxisScale(&p.Z_axis, z_range.min, z_range.max, -1, 2),
+		width, height)
+
+	p.generateAxes(driver, proj)
+
+	//	the surfaces of all sets are painted from the farthest cell to the nearest one, hiding what is behind them
+	var cells []surfaceCell
+
+	for i := range set_points {
+		cells = append(cells, set_points[i].surfaceCells(proj, i, p.Hidden3d)...)
+	}
+	sort.SliceStable(cells, func(i, j int) bool {
+		return cells[i].depth > cells[j].depth
+	})
+
+	for _, cell := range cells {
+		set := &set_points[cell.set]
+		colour := set.Line_style.colour(cell.set)
+
+		driver.SetLineStyle(set.Line_style.lineWidth(), set.Line_style.dashType())
+
+		if set.Style == PM3D {
+			cellColour := colours.colour(cell.z)
+
+			driver.FillPolygon(cell.point, cellColour, Fill_style{})
+			driver.Polygon(cell.point, cellColour, false)
+			continue
+		}
+
+		//	the cells hide the ones behind them with the background colour
+		driver.FillPolygon(cell.point, p.Terminal_options.background(), Fill_style{})
+		driver.Polygon(cell.point, colour, false)
+	}
+	driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+
+	//	generate the lines and points of every set, and the legend
+	for i, set := range set_points {
+		if set.order == 0 {
+			set.order = uint8(i + 1)
+		}
+
+		set.generatePlot(driver, width, height, proj, p.Hidden3d, set.Line_style.colour(i), set.Line_style.pointType(i), colours)
+	}
+
+	//	add the axes titles
+	p.generateAxesLabels(driver, proj)
+
+	return nil
+}
+
+//	grid return the distinct x and y values of the points of a set and their z values, when the set has a single
+//	point for each node of a grid
+func (set *Set_points_3d) grid() ([]float64, []float64, [][]float64, bool) {
+
+	flat := Set_points_2d{Point: make([]Point_2d, len(set.Point))}
+	for i, point := range set.Point {
+		flat.Point[i] = Point_2d{X: point.X, Y: point.Y}
+	}
+
+	column, row := flat.imageGrid()
+	if len(column) < 2 || len(row) < 2 || len(column)*len(row) != len(set.Point) {
+		return nil, nil, nil, false
+	}
+
+	columnIndex := make(map[float64]int)
+	for i, x := range column {
+		columnIndex[x] = i
+	}
+	rowIndex := make(map[float64]int)
+	for j, y := range row {
+		rowIndex[y] = j
+	}
+
+	value := make([][]float64, len(row))
+	for j := range value {
+		value[j] = make([]float64, len(column))
+		for i := range value[j] {
+			value[j][i] = math.NaN()
+		}
+	}
+
+	for _, point := range set.Point {
+		value[rowIndex[point.Y]][columnIndex[point.X]] = point.Z
+	}
+
+	return column, row, value, true
+}
+
+//	cell of a surface projected into the driver, with it's depth and mean z
+type surfaceCell struct {
+	set   int
+	point []DriverPoint
+	depth float64
+	z     float64
+}
+
+//	surfaceCells return the cells of the surface of a set, which are painted by the pm3d style or used to hide the
+//	lines behind the surface (cells with any corner out of the axes' ranges are left out)
+func (set *Set_points_3d) surfaceCells(proj *projection, index int, hidden bool) []surfaceCell {
+
+	if set.Style != PM3D && !(hidden && (set.Style == LINES || set.Style == LINES_POINTS)) {
+		return nil
+	}
+
+	column, row, value, ok := set.grid()
+	if !ok {
+		return nil
+	}
+
+	var cells []surfaceCell
+
+	for j := 0; j+1 < len(row); j++ {
+		for i := 0; i+1 < len(column); i++ {
+			corner := []Point_3d{
+				{X: column[i], Y: row[j], Z: value[j][i]},
+				{X: column[i+1], Y: row[j], Z: value[j][i+1]},
+				{X: column[i+1], Y: row[j+1], Z: value[j+1][i+1]},
+				{X: column[i], Y: row[j+1], Z: value[j+1][i]},
+			}
+
+			cell := surfaceCell{set: index}
+			inside := true
+
+			for k := range corner {
+				if !proj.contains(&corner[k]) {
+					inside = false
+					break
+				}
+
+				scaled_x, scaled_y, depth := proj.project(corner[k].X, corner[k].Y, corner[k].Z)
+
+				cell.point = append(cell.point, DriverPoint{X: int64(scaled_x), Y: int64(scaled_y)})
+				cell.depth += depth / 4
+				cell.z += corner[k].Z / 4
+			}
+
+			if inside {
+				cells = append(cells, cell)
+			}
+		}
+	}
+
+	return cells
+}
+
+//	generatePlot generate the lines and markers of a set of 3D points, with it's legend entry (the lines of a grid
+//	make a mesh, which is drawn with the surface cells when the hidden lines are removed)
+func (set *Set_points_3d) generatePlot(driver GraphicsDriver, plotWidth, plotHeight int64, proj *projection, hidden bool, colour RGB_colour, pointType uint8, colours *colourMap) {
+
+	driver.Comment("plotting " + set.Title)
+
+	lineWidth := set.Line_style.lineWidth()
+	pointWidth := POINT_WIDTH * set.Line_style.pointSize()
+
+	driver.SetLineStyle(lineWidth, set.Line_style.dashType())
+	defer driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+
+	//	draw a line through a sequence of points, broken where they are out of the axes' ranges
+	drawLine := func(point []Point_3d) {
+		var path []DriverPoint
+
+		for k := 0; k <= len(point); k++ {
+			if k < len(point) && proj.contains(&point[k]) {
+				path = append(path, proj.point(point[k].X, point[k].Y, point[k].Z))
+				continue
+			}
+
+			if len(path) > 1 {
+				driver.BeginPath(colour)
+				for _, driverPoint := range path {
+					driver.PointToPath(driverPoint.X, driverPoint.Y)
+				}
+				driver.EndPath()
+			}
+			path = nil
+		}
+	}
+
+	if set.Style == LINES || set.Style == LINES_POINTS {
+		column, row, value, ok := set.grid()
+
+		switch {
+		case ok && hidden:
+			//	the mesh was drawn with the surface cells
+
+		case ok:
+			//	the mesh is made of the lines of each row and column of the grid
+			for j := range row {
+				line := make([]Point_3d, len(column))
+				for i := range column {
+					line[i] = Point_3d{X: column[i], Y: row[j], Z: value[j][i]}
+				}
+				drawLine(line)
+			}
+			for i := range column {
+				line := make([]Point_3d, len(row))
+				for j := range row {
+					line[j] = Point_3d{X: column[i], Y: row[j], Z: value[j][i]}
+				}
+				drawLine(line)
+			}
+
+		default:
+			drawLine(set.Point)
+		}
+	}
+
+	//	generate a marker or a dot for each point (points are never dashed)
+	driver.SetLineStyle(lineWidth, DASH_SOLID)
+
+	for _, point := range set.Point {
+		if !proj.contains(&point) {
+			continue
+		}
+		scaled_x, scaled_y, _ := proj.project(point.X, point.Y, point.Z)
+
+		switch set.Style {
+		case POINTS, LINES_POINTS:
+			drawMarker(driver, scaled_x, scaled_y, pointType, pointWidth, colour)
+
+		case DOTS:
+			driver.Point(int64(scaled_x), int64(scaled_y), colour)
+		}
+	}
+
+	//	show the title with a sample of the line style
+	generateLegendEntry(driver, plotWidth, plotHeight, set.order, set.Title, func(x1, x2, y int64) {
+		if set.Style == PM3D {
+			swatch := []DriverPoint{{X: x1, Y: y - POINT_WIDTH/2}, {X: x2, Y: y - POINT_WIDTH/2}, {X: x2, Y: y + POINT_WIDTH/2}, {X: x1, Y: y + POINT_WIDTH/2}}
+
+			driver.FillPolygon(swatch, colours.palette.colour(0.5), Fill_style{})
+			return
+		}
+		if set.Style == LINES || set.Style == LINES_POINTS {
+			driver.SetLineStyle(lineWidth, set.Line_style.dashType())
+			driver.Line(x1, y, x2, y, colour)
+		}
+		if set.Style == POINTS || set.Style == LINES_POINTS {
+			driver.SetLineStyle(lineWidth, DASH_SOLID)
+			drawMarker(driver, float64(x1+x2)/2, float64(y), pointType, pointWidth, colour)
+		}
+		if set.Style == DOTS {
+			driver.Point((x1+x2)/2, y, colour)
+		}
+	})
+}
+
+//	base of the 3D axes: the edges of the x and y axes nearest to the viewer, and the corner of the z axis
+type axesBase struct {
+	x_edge_y   float64
+	y_edge_x   float64
+	z_corner_x float64
+	z_corner_y float64
+}
+
+//	axesBase choose the edges of the base of the plot used for the scales of the axes
+func (proj *projection) axesBase() axesBase {
+
+	x_min, x_max := proj.x_scale.min, proj.x_scale.max
+	y_min, y_max := proj.y_scale.min, proj.y_scale.max
+	z_min := proj.z_scale.min
+
+	depth := func(x, y float64) float64 {
+		_, _, d := proj.project(x, y, z_min)
+		return d
+	}
+
+	base := axesBase{x_edge_y: y_min, y_edge_x: x_min}
+
+	if depth((x_min+x_max)/2, y_max) < depth((x_min+x_max)/2, y_min)-TICK_EPSILON {
+		base.x_edge_y = y_max
+	}
+	if depth(x_max, (y_min+y_max)/2) < depth(x_min, (y_min+y_max)/2)-TICK_EPSILON {
+		base.y_edge_x = x_max
+	}
+
+	//	the z axis is in the leftmost corner of the base
+	leftmost := math.Inf(1)
+
+	for _, corner := range [][2]float64{{x_min, y_min}, {x_max, y_min}, {x_max, y_max}, {x_min, y_max}} {
+		scaled_x, _, _ := proj.project(corner[0], corner[1], z_min)
+
+		if scaled_x < leftmost-TICK_EPSILON {
+			leftmost = scaled_x
+			base.z_corner_x, base.z_corner_y = corner[0], corner[1]
+		}
+	}
+
+	return base
+}
+
+//	outwards return the unit vector in driver coordinates from the centre of the base to a point of it's border
+func (proj *projection) outwards(x, y float64) (float64, float64) {
+
+	centre_x, centre_y, _ := proj.project((proj.x_scale.min+proj.x_scale.max)/2, (proj.y_scale.min+proj.y_scale.max)/2, proj.z_scale.min)
+	scaled_x, scaled_y, _ := proj.project(x, y, proj.z_scale.min)
+
+	length := math.Hypot(scaled_x-centre_x, scaled_y-centre_y)
+	if length == 0 {
+		return 0, -1
+	}
+
+	return (scaled_x - centre_x) / length, (scaled_y - centre_y) / length
+}
+
+//	generateAxes generate the border of the base, the z axis and the scales of the three axes
+func (p *Plot_3D) generateAxes(driver GraphicsDriver, proj *projection) {
+
+	driver.Comment("3D axes")
+
+	x_min, x_max := proj.x_scale.min, proj.x_scale.max
+	y_min, y_max := proj.y_scale.min, proj.y_scale.max
+	z_min, z_max := proj.z_scale.min, proj.z_scale.max
+
+	driver.Polygon([]DriverPoint{proj.point(x_min, y_min, z_min), proj.point(x_max, y_min, z_min), proj.point(x_max, y_max, z_min), proj.point(x_min, y_max, z_min)}, BLACK, false)
+
+	base := proj.axesBase()
+
+	z_bottom := proj.point(base.z_corner_x, base.z_corner_y, z_min)
+	z_top := proj.point(base.z_corner_x, base.z_corner_y, z_max)
+	driver.Line(z_bottom.X, z_bottom.Y, z_top.X, z_top.Y, BLACK)
+
+	//	the ticks of the x and y axes point outwards of the base, with their labels after them
+	generateTicks := func(scale *axisScale, position func(value float64) (float64, float64), out_x, out_y float64) {
+		for _, tick := range scale.ticks(MIN_3D_SCALE_DIVISIONS, MAX_3D_SCALE_DIVISIONS) {
+			x, y := position(tick.value)
+			scaled_x, scaled_y, _ := proj.project(x, y, z_min)
+
+			tickWidth := float64(SCALE_WIDTH)
+			if tick.minor {
+				tickWidth /= 2
+			}
+
+			driver.Line(int64(scaled_x), int64(scaled_y), int64(scaled_x+out_x*tickWidth), int64(scaled_y+out_y*tickWidth), BLACK)
+
+			if len(tick.label) > 0 {
+				textWidth, textHeight := driver.GetTextBox(tick.label)
+
+				label_x := scaled_x + out_x*(2*SCALE_WIDTH+float64(textWidth)/2) - float64(textWidth)/2
+				label_y := scaled_y + out_y*(2*SCALE_WIDTH+float64(textHeight)/2) - float64(textHeight)/2
+
+				driver.Text(int64(label_x), int64(label_y), 0, tick.label, BLACK)
+			}
+		}
+	}
+
+	out_x, out_y := proj.outwards((x_min+x_max)/2, base.x_edge_y)
+	generateTicks(proj.x_scale, func(value float64) (float64, float64) { return value, base.x_edge_y }, out_x, out_y)
+
+	out_x, out_y = proj.outwards(base.y_edge_x, (y_min+y_max)/2)
+	generateTicks(proj.y_scale, func(value float64) (float64, float64) { return base.y_edge_x, value }, out_x, out_y)
+
+	//	the ticks of the z axis point to the left
+	for _, tick := range proj.z_scale.ticks(MIN_3D_SCALE_DIVISIONS, MAX_3D_SCALE_DIVISIONS) {
+		scaled_x, scaled_y, _ := proj.project(base.z_corner_x, base.z_corner_y, tick.value)
+
+		tickWidth := int64(SCALE_WIDTH)
+		if tick.minor {
+			tickWidth /= 2
+		}
+
+		driver.Line(int64(scaled_x)-tickWidth, int64(scaled_y), int64(scaled_x), int64(scaled_y), BLACK)
+
+		if len(tick.label) > 0 {
+			textWidth, textHeight := driver.GetTextBox(tick.label)
+
+			driver.Text(int64(scaled_x)-2*SCALE_WIDTH-textWidth, int64(scaled_y)-textHeight/2, 0, tick.label, BLACK)
+		}
+	}
+}
+
+//	generateAxesLabels generate the titles of the axes beyond their scales
+func (p *Plot_3D) generateAxesLabels(driver GraphicsDriver, proj *projection) {
+
+	base := proj.axesBase()
+
+	generateLabel := func(label string, x, y float64) {
+		if len(label) == 0 {
+			return
+		}
+
+		textWidth, textHeight := driver.GetTextBox(label)
+		out_x, out_y := proj.outwards(x, y)
+		scaled_x, scaled_y, _ := proj.project(x, y, proj.z_scale.min)
+
+		distance := 4*SCALE_WIDTH + 2*float64(textHeight)
+
+		driver.Text(int64(scaled_x+out_x*distance)-textWidth/2, int64(scaled_y+out_y*distance)-textHeight/2, 0, label, BLACK)
+	}
+
+	generateLabel(p.X_label, (proj.x_scale.min+proj.x_scale.max)/2, base.x_edge_y)
+	generateLabel(p.Y_label, base.y_edge_x, (proj.y_scale.min+proj.y_scale.max)/2)
+
+	if len(p.Z_label) > 0 {
+		textWidth, _ := driver.GetTextBox(p.Z_label)
+		z_top := proj.point(base.z_corner_x, base.z_corner_y, proj.z_scale.max)
+
+		driver.Text(z_top.X-textWidth/2, z_top.Y+2*SCALE_WIDTH, 0, p.Z_label, BLACK)
+	}
+}
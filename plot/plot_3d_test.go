@@ -0,0 +1,171 @@
+////////////////////////////////////////////////////////////////////////////////
+//	plot_3d_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the 3D plots
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//	TestPlot3D unit tests for the 3D plots
+func TestPlot3D(t *testing.T) {
+
+	t.Run(">>> View: validate the angles and scale", func(t *testing.T) {
+
+		for _, test := range []struct {
+			view View
+			want string
+		}{
+			{view: View{Rot_x: 60, Rot_z: 30, Scale: 1}, want: ""},
+			{view: View{Rot_x: 190, Rot_z: 30, Scale: 1}, want: "view rotation around x expected to be from 0 to 180"},
+			{view: View{Rot_x: 60, Rot_z: -1, Scale: 1}, want: "view rotation around z expected to be from 0 to 360"},
+			{view: View{Rot_x: 60, Rot_z: 30}, want: "view scale expected to be positive"},
+		} {
+			err := test.view.Validate()
+			//	check the result
+			if (err == nil && len(test.want) > 0) || (err != nil && err.Error() != test.want) {
+				t.Errorf("failed validating view %v: expected: %s result: %v", test.view, test.want, err)
+			}
+		}
+	})
+
+	t.Run(">>> parseView: angles, scale and map", func(t *testing.T) {
+
+		for _, test := range []struct {
+			options []string
+			want    View
+		}{
+			{options: []string{"", "45", "120", ""}, want: View{Rot_x: 45, Rot_z: 120, Scale: 1}},
+			{options: []string{"", "45", "120", "1.5"}, want: View{Rot_x: 45, Rot_z: 120, Scale: 1.5}},
+			{options: []string{"map", "", "", ""}, want: View{Rot_x: 0, Rot_z: 0, Scale: 1}},
+		} {
+			got, err := parseView(test.options[0], test.options[1], test.options[2], test.options[3])
+			//	check the result
+			if err != nil || !reflect.DeepEqual(test.want, *got) {
+				t.Errorf("failed parsing view %v: expected: %v result: %v %v", test.options, test.want, got, err)
+			}
+		}
+
+		_, err := parseView("", "200", "30", "")
+		//	check the result
+		if err == nil {
+			t.Errorf("failed parsing view: expected an error for rotation 200 around x")
+		}
+	})
+
+	t.Run(">>> projection: view from above", func(t *testing.T) {
+
+		x_scale := newAxisScale(&Axis{}, 0, 10, -1, 2)
+		y_scale := newAxisScale(&Axis{}, 0, 10, -1, 2)
+		z_scale := newAxisScale(&Axis{}, 0, 10, -1, 2)
+
+		proj := newProjection(&View{Scale: 1}, x_scale, y_scale, z_scale, 400, 400)
+
+		//	looking from above, x grows to the right and y grows upwards, regardless of z
+		x1, y1, _ := proj.project(0, 0, 0)
+		x2, y2, _ := proj.project(10, 10, 10)
+		//	check the result
+		if x2 <= x1 || y2 <= y1 {
+			t.Errorf("failed projecting points: expected 10,10 to the upper right of 0,0 result: %v,%v %v,%v", x1, y1, x2, y2)
+		}
+		x3, y3, _ := proj.project(0, 0, 10)
+		if math.Abs(x3-x1) > 1e-9 || math.Abs(y3-y1) > 1e-9 {
+			t.Errorf("failed projecting points: expected z not to move the points result: %v,%v %v,%v", x1, y1, x3, y3)
+		}
+	})
+
+	t.Run(">>> grid: points in a grid and scattered points", func(t *testing.T) {
+
+		set := Set_points_3d{Point: []Point_3d{{X: 0, Y: 0, Z: 1}, {X: 1, Y: 0, Z: 2}, {X: 0, Y: 1, Z: 3}, {X: 1, Y: 1, Z: 4}}}
+
+		column, row, value, ok := set.grid()
+		//	check the result
+		if !ok || !reflect.DeepEqual(column, []float64{0, 1}) || !reflect.DeepEqual(row, []float64{0, 1}) || !reflect.DeepEqual(value, [][]float64{{1, 2}, {3, 4}}) {
+			t.Errorf("failed evaluating the grid: expected 2 x 2 values result: %v %v %v", column, row, value)
+		}
+
+		set.Point = set.Point[:3]
+		_, _, _, ok = set.grid()
+		if ok {
+			t.Errorf("failed evaluating the grid: expected no grid for 3 points")
+		}
+	})
+
+	t.Run(">>> generate: nothing to be plotted", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		plot := &Plot_3D{}
+
+		want := "no set of points or functions to be plotted"
+		err := plot.generate(NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100}))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed generating 3D plot: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> generate: mesh with hidden lines removed", func(t *testing.T) {
+
+		var cells [2]int
+
+		for i, hidden := range []bool{false, true} {
+			var output bytes.Buffer
+
+			writer := bufio.NewWriter(&output)
+			plot := &Plot_3D{
+				Hidden3d:      hidden,
+				Iso_samples_x: 3,
+				Function:      []Function_3d{{Title: "x*y", Style: LINES, Function: "x*y", Min_x: -1, Max_x: 1, Min_y: -1, Max_y: 1}},
+			}
+
+			err := plot.generate(NewSVG_Driver(writer, &TerminalOptions{Width: 400, Height: 300}))
+			writer.Flush()
+			if err != nil {
+				t.Errorf("fail generating 3D plot: %s", err.Error())
+				return
+			}
+
+			cells[i] = strings.Count(output.String(), "fill:#ffffff")
+		}
+
+		//	check the result: the hidden line removal fills each of the 4 cells of the mesh in white
+		if cells[1]-cells[0] != 4 {
+			t.Errorf("failed generating 3D plot: expected 4 filled cells result: %d", cells[1]-cells[0])
+		}
+	})
+
+	t.Run(">>> generate: hidden lines removed with the terminal background", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		plot := &Plot_3D{
+			Terminal_options: TerminalOptions{Width: 400, Height: 300, Background: &RGB_colour{red: 0x20, green: 0x20, blue: 0x20}},
+			Hidden3d:         true,
+			Iso_samples_x:    3,
+			Function:         []Function_3d{{Title: "x*y", Style: LINES, Function: "x*y", Min_x: -1, Max_x: 1, Min_y: -1, Max_y: 1}},
+		}
+
+		err := plot.generate(NewSVG_Driver(writer, &plot.Terminal_options))
+		writer.Flush()
+		if err != nil {
+			t.Errorf("fail generating 3D plot: %s", err.Error())
+			return
+		}
+
+		//	check the result: the terminal and the 4 cells of the mesh are filled with the background instead of white
+		if strings.Count(output.String(), "fill:#202020") != 5 || strings.Contains(output.String(), "fill:#ffffff") {
+			t.Errorf("failed generating 3D plot: expected 4 cells filled with the background result: %s", output.String())
+		}
+	})
+}
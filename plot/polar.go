@@ -0,0 +1,189 @@
+////////////////////////////////////////////////////////////////////////////////
+//	polar.go  -  Oct-19-2026  -  aldebap
+//
+//	Polar coordinates and polar grid of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/aldebap/go-plot/expression"
+)
+
+//	number of segments used to draw the circles of a polar grid
+const (
+	POLAR_GRID_SEGMENTS = 360
+)
+
+//	angleFactor return the factor to convert angles in the unit of the plot to radians
+func angleFactor(unit uint8) float64 {
+	if unit == expression.DEGREES {
+		return math.Pi / 180
+	}
+
+	return 1
+}
+
+//	polarToCartesian convert a set of angle and radius points into cartesian coordinates
+func (set *Set_points_2d) polarToCartesian(unit uint8) Set_points_2d {
+
+	factor := angleFactor(unit)
+	cartesian := *set
+	cartesian.Point = make([]Point_2d, len(set.Point))
+
+	for i, point := range set.Point {
+		cartesian.Point[i].X = point.Y * math.Cos(point.X*factor)
+		cartesian.Point[i].Y = point.Y * math.Sin(point.X*factor)
+	}
+
+	return cartesian
+}
+
+//	clipSegment clip a segment to a rectangle (Liang-Barsky), returning false when it's outside the rectangle
+func clipSegment(x1, y1, x2, y2, min_x, min_y, max_x, max_y float64) (float64, float64, float64, float64, bool) {
+
+	dx := x2 - x1
+	dy := y2 - y1
+	p := []float64{-dx, dx, -dy, dy}
+	q := []float64{x1 - min_x, max_x - x1, y1 - min_y, max_y - y1}
+	t0, t1 := 0.0, 1.0
+
+	for i := range p {
+		if p[i] == 0 {
+			if q[i] < 0 {
+				return 0, 0, 0, 0, false
+			}
+			continue
+		}
+
+		r := q[i] / p[i]
+		if p[i] < 0 {
+			if r > t1 {
+				return 0, 0, 0, 0, false
+			}
+			if r > t0 {
+				t0 = r
+			}
+		} else {
+			if r < t0 {
+				return 0, 0, 0, 0, false
+			}
+			if r < t1 {
+				t1 = r
+			}
+		}
+	}
+
+	return x1 + t0*dx, y1 + t0*dy, x1 + t1*dx, y1 + t1*dy, true
+}
+
+//	generatePolarGrid implementation of the radial circles and angle lines of a polar grid
+func (p *Plot_2D) generatePolarGrid(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	//	a polar grid is meaningless in logarithmic axes
+	if x_scale.log || y_scale.log {
+		return
+	}
+
+	//	the circles must reach the farthest corner of the plot area
+	radius := 0.0
+	for _, x := range []float64{x_scale.min, x_scale.max} {
+		for _, y := range []float64{y_scale.min, y_scale.max} {
+			if distance := math.Hypot(x, y); distance > radius {
+				radius = distance
+			}
+		}
+	}
+
+	//	the radial circles are spaced as the major ticks of the x axis
+	var ticks []float64
+	for _, tick := range x_scale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS) {
+		if !tick.minor {
+			ticks = append(ticks, tick.value)
+		}
+	}
+
+	driver.Comment("polar grid circles")
+
+	if len(ticks) >= 2 && ticks[1] > ticks[0] {
+		step := ticks[1] - ticks[0]
+
+		for r := step; r <= radius; r += step {
+			polarGridCircle(driver, x_scale, y_scale, r, colour)
+		}
+	}
+
+	//	add a line from the origin with a label for each angle step
+	driver.Comment("polar grid angles")
+
+	factor := angleFactor(p.Angles)
+	step := math.Pi / 6
+	if p.Grid.Polar_step > 0 {
+		step = p.Grid.Polar_step * factor
+	}
+
+	for i := 0; float64(i)*step < 2*math.Pi-TICK_EPSILON; i++ {
+		angle := float64(i) * step
+
+		x1, y1, x2, y2, inside := clipSegment(0, 0, radius*math.Cos(angle), radius*math.Sin(angle), x_scale.min, y_scale.min, x_scale.max, y_scale.max)
+		if !inside || (x1 == x2 && y1 == y2) {
+			continue
+		}
+		driver.Line(int64(x_scale.scale(x1)), int64(y_scale.scale(y1)), int64(x_scale.scale(x2)), int64(y_scale.scale(y2)), colour)
+
+		//	the label is placed inside the plot area, at the end of the line
+		label := strconv.FormatFloat(angle/factor, 'g', 4, 64)
+		if p.Angles == expression.DEGREES {
+			label += "°"
+		}
+
+		textWidth, textHeight := driver.GetTextBox(label)
+		offset := float64(textWidth)/2 + float64(textHeight)/2
+
+		center_x := x_scale.scale(x2) - offset*math.Cos(angle)
+		center_y := y_scale.scale(y2) - offset*math.Sin(angle)
+
+		driver.Text(int64(center_x)-textWidth/2, int64(center_y)-textHeight/2, 0, label, BLACK)
+	}
+}
+
+//	polarGridCircle draw the parts of a polar grid circle inside the plot area, each one as a path
+func polarGridCircle(driver GraphicsDriver, x_scale, y_scale *axisScale, radius float64, colour RGB_colour) {
+
+	open := false
+
+	for i := 0; i < POLAR_GRID_SEGMENTS; i++ {
+		angle1 := 2 * math.Pi * float64(i) / POLAR_GRID_SEGMENTS
+		angle2 := 2 * math.Pi * float64(i+1) / POLAR_GRID_SEGMENTS
+		end_x, end_y := radius*math.Cos(angle2), radius*math.Sin(angle2)
+
+		x1, y1, x2, y2, inside := clipSegment(radius*math.Cos(angle1), radius*math.Sin(angle1), end_x, end_y, x_scale.min, y_scale.min, x_scale.max, y_scale.max)
+		if !inside {
+			if open {
+				driver.EndPath()
+				open = false
+			}
+			continue
+		}
+
+		if !open {
+			driver.BeginPath(colour)
+			driver.PointToPath(int64(x_scale.scale(x1)), int64(y_scale.scale(y1)))
+			open = true
+		}
+		driver.PointToPath(int64(x_scale.scale(x2)), int64(y_scale.scale(y2)))
+
+		//	the path ends where the circle leaves the plot area
+		if math.Hypot(x2-end_x, y2-end_y) > TICK_EPSILON*radius {
+			driver.EndPath()
+			open = false
+		}
+	}
+
+	if open {
+		driver.EndPath()
+	}
+}
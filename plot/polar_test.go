@@ -0,0 +1,83 @@
+////////////////////////////////////////////////////////////////////////////////
+//	polar_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for polar coordinates and polar grid
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aldebap/go-plot/expression"
+)
+
+//	TestPolar unit tests for polar coordinates conversion and segment clipping
+func TestPolar(t *testing.T) {
+
+	t.Run(">>> polarToCartesian: angles in degrees", func(t *testing.T) {
+
+		set := Set_points_2d{Point: []Point_2d{{X: 0, Y: 2}, {X: 90, Y: 1}, {X: 180, Y: 3}}}
+
+		want := []Point_2d{{X: 2, Y: 0}, {X: 0, Y: 1}, {X: -3, Y: 0}}
+		got := set.polarToCartesian(expression.DEGREES)
+		//	check the result
+		for i := range want {
+			if math.Abs(want[i].X-got.Point[i].X) > 1e-9 || math.Abs(want[i].Y-got.Point[i].Y) > 1e-9 {
+				t.Errorf("failed converting polar coordinates: expected: %v result: %v", want, got.Point)
+				break
+			}
+		}
+		if set.Point[1].X != 90 {
+			t.Errorf("failed converting polar coordinates: original set changed: %v", set.Point)
+		}
+	})
+
+	t.Run(">>> clipSegment: segments crossing and outside the rectangle", func(t *testing.T) {
+
+		x1, y1, x2, y2, inside := clipSegment(0, 0, 10, 5, -1, -1, 4, 4)
+
+		want := []float64{0, 0, 4, 2}
+		got := []float64{x1, y1, x2, y2}
+		//	check the result
+		if !inside || !reflect.DeepEqual(want, got) {
+			t.Errorf("failed clipping segment: expected: %v result: %v %v", want, got, inside)
+		}
+
+		_, _, _, _, inside = clipSegment(5, 5, 10, 5, -1, -1, 4, 4)
+		if inside {
+			t.Errorf("failed clipping segment: expected segment outside the rectangle")
+		}
+	})
+
+	t.Run(">>> polarGridCircle: a path for each part of the circle inside the plot area", func(t *testing.T) {
+
+		for _, test := range []struct {
+			radius float64
+			want   int
+		}{
+			{1, 1},
+			{2.5, 4},
+			{3, 0},
+		} {
+			var output bytes.Buffer
+
+			writer := bufio.NewWriter(&output)
+			driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+			driver.SetDimensions(100, 100)
+
+			polarGridCircle(driver, newAxisScale(&Axis{}, -2, 2, 0, 100), newAxisScale(&Axis{}, -2, 2, 0, 100), test.radius, BLACK)
+			writer.Flush()
+
+			//	check the result
+			if strings.Count(output.String(), "<path") != test.want || strings.Contains(output.String(), "<line") {
+				t.Errorf("failed drawing polar grid circle of radius %g: expected %d paths result: %s", test.radius, test.want, output.String())
+			}
+		}
+	})
+}
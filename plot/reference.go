@@ -0,0 +1,212 @@
+////////////////////////////////////////////////////////////////////////////////
+//	reference.go  -  Oct-19-2026  -  aldebap
+//
+//	Reference lines, shaded bands and zero axes of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+//	orientation of reference lines and bands
+const (
+	HORIZONTAL uint8 = 1
+	VERTICAL   uint8 = 2
+)
+
+//	default colour for shaded bands
+var (
+	BAND_COLOUR = RGB_colour{red: 230, green: 230, blue: 230}
+)
+
+//	orientation names
+var Orientation = map[string]uint8{
+	"horizontal": HORIZONTAL,
+	"vertical":   VERTICAL,
+}
+
+//	line in a constant y (horizontal) or x (vertical) value
+type Reference_line struct {
+	Orientation uint8
+	Value       float64
+	Title       string
+	Line_style  Line_style
+}
+
+//	shaded interval of y (horizontal) or x (vertical) values
+type Reference_band struct {
+	Orientation uint8
+	From        float64
+	To          float64
+	Title       string
+	Colour      *RGB_colour
+}
+
+//	AddHorizontalLine add a reference line in a constant y value (shown in the legend when title is not empty)
+func (p *Plot_2D) AddHorizontalLine(y float64, title string, style Line_style) {
+	p.Reference_lines = append(p.Reference_lines, Reference_line{
+		Orientation: HORIZONTAL,
+		Value:       y,
+		Title:       title,
+		Line_style:  style,
+	})
+}
+
+//	AddVerticalLine add a reference line in a constant x value (shown in the legend when title is not empty)
+func (p *Plot_2D) AddVerticalLine(x float64, title string, style Line_style) {
+	p.Reference_lines = append(p.Reference_lines, Reference_line{
+		Orientation: VERTICAL,
+		Value:       x,
+		Title:       title,
+		Line_style:  style,
+	})
+}
+
+//	AddXBand add a shaded interval of x values (shown in the legend when title is not empty)
+func (p *Plot_2D) AddXBand(from, to float64, title string, colour *RGB_colour) {
+	p.Reference_bands = append(p.Reference_bands, Reference_band{
+		Orientation: VERTICAL,
+		From:        from,
+		To:          to,
+		Title:       title,
+		Colour:      colour,
+	})
+}
+
+//	AddYBand add a shaded interval of y values (shown in the legend when title is not empty)
+func (p *Plot_2D) AddYBand(from, to float64, title string, colour *RGB_colour) {
+	p.Reference_bands = append(p.Reference_bands, Reference_band{
+		Orientation: HORIZONTAL,
+		From:        from,
+		To:          to,
+		Title:       title,
+		Colour:      colour,
+	})
+}
+
+//	colour return the colour of the band or the default one
+func (band *Reference_band) colour() RGB_colour {
+	if band.Colour == nil {
+		return BAND_COLOUR
+	}
+
+	return *band.Colour
+}
+
+//	clamp limit a value to the interval of the driver coordinates of an axis
+func clamp(value float64, scale *axisScale) float64 {
+	if value < scale.offset {
+		return scale.offset
+	}
+	if value > scale.offset+scale.length {
+		return scale.offset + scale.length
+	}
+
+	return value
+}
+
+//	generateReferenceBands implementation of 2D Go_Plot shaded bands generation
+func (p *Plot_2D) generateReferenceBands(driver GraphicsDriver, x_scale, y_scale *axisScale) {
+
+	for _, band := range p.Reference_bands {
+		driver.Comment("reference band " + band.Title)
+
+		x1, x2 := x_scale.offset, x_scale.offset+x_scale.length
+		y1, y2 := y_scale.offset, y_scale.offset+y_scale.length
+
+		if band.Orientation == VERTICAL {
+			x1 = clamp(x_scale.scale(band.From), x_scale)
+			x2 = clamp(x_scale.scale(band.To), x_scale)
+		} else {
+			y1 = clamp(y_scale.scale(band.From), y_scale)
+			y2 = clamp(y_scale.scale(band.To), y_scale)
+		}
+
+		//	skip the bands outside the plot
+		if x1 == x2 || y1 == y2 {
+			continue
+		}
+
+		driver.Polygon([]DriverPoint{
+			{X: int64(x1), Y: int64(y1)},
+			{X: int64(x2), Y: int64(y1)},
+			{X: int64(x2), Y: int64(y2)},
+			{X: int64(x1), Y: int64(y2)},
+		}, band.colour(), true)
+	}
+}
+
+//	generateReferenceLines implementation of 2D Go_Plot zero axes and reference lines generation
+func (p *Plot_2D) generateReferenceLines(driver GraphicsDriver, x_scale, y_scale *axisScale) {
+
+	lines := make([]Reference_line, 0, len(p.Reference_lines)+2)
+
+	//	zero axes are reference lines in the origin of the axes
+	if p.X_zero_axis != nil && !y_scale.log {
+		lines = append(lines, Reference_line{Orientation: HORIZONTAL, Line_style: *p.X_zero_axis})
+	}
+	if p.Y_zero_axis != nil && !x_scale.log {
+		lines = append(lines, Reference_line{Orientation: VERTICAL, Line_style: *p.Y_zero_axis})
+	}
+	lines = append(lines, p.Reference_lines...)
+
+	for _, line := range lines {
+		driver.Comment("reference line " + line.Title)
+
+		colour := BLACK
+		if line.Line_style.Colour != nil {
+			colour = *line.Line_style.Colour
+		}
+
+		driver.SetLineStyle(line.Line_style.lineWidth(), line.Line_style.dashType())
+
+		if line.Orientation == VERTICAL {
+			if x_scale.contains(line.Value) {
+				x := int64(x_scale.scale(line.Value))
+
+				driver.Line(x, int64(y_scale.offset), x, int64(y_scale.offset+y_scale.length), colour)
+			}
+		} else {
+			if y_scale.contains(line.Value) {
+				y := int64(y_scale.scale(line.Value))
+
+				driver.Line(int64(x_scale.offset), y, int64(x_scale.offset+x_scale.length), y, colour)
+			}
+		}
+	}
+
+	driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+}
+
+//	generateReferenceLegend show the titles of reference lines and bands after the ones of the data
+func (p *Plot_2D) generateReferenceLegend(driver GraphicsDriver, plotWidth, plotHeight int64, order uint8) {
+
+	for _, band := range p.Reference_bands {
+		if len(band.Title) == 0 {
+			continue
+		}
+		order++
+
+		colour := band.colour()
+
+		generateLegendEntry(driver, plotWidth, plotHeight, order, band.Title, func(x1, x2, y int64) {
+			driver.Polygon([]DriverPoint{{X: x1, Y: y - 3}, {X: x2, Y: y - 3}, {X: x2, Y: y + 3}, {X: x1, Y: y + 3}}, colour, true)
+		})
+	}
+
+	for _, line := range p.Reference_lines {
+		if len(line.Title) == 0 {
+			continue
+		}
+		order++
+
+		colour := BLACK
+		if line.Line_style.Colour != nil {
+			colour = *line.Line_style.Colour
+		}
+
+		generateLegendEntry(driver, plotWidth, plotHeight, order, line.Title, func(x1, x2, y int64) {
+			driver.SetLineStyle(line.Line_style.lineWidth(), line.Line_style.dashType())
+			driver.Line(x1, y, x2, y, colour)
+			driver.SetLineStyle(DEFAULT_LINE_WIDTH, DASH_SOLID)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+////////////////////////////////////////////////////////////////////////////////
+//	reference_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for reference lines, shaded bands and zero axes
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"reflect"
+	"testing"
+)
+
+//	TestReferences unit tests for the reference lines and bands API
+func TestReferences(t *testing.T) {
+
+	t.Run(">>> AddHorizontalLine, AddXBand: orientation of references", func(t *testing.T) {
+
+		var plot Plot_2D
+
+		plot.AddHorizontalLine(99.5, "SLA", Line_style{Colour: &RED, Dash_type: DASH_DASHED})
+		plot.AddXBand(2, 4, "maintenance", nil)
+
+		wantLines := []Reference_line{{Orientation: HORIZONTAL, Value: 99.5, Title: "SLA", Line_style: Line_style{Colour: &RED, Dash_type: DASH_DASHED}}}
+		wantBands := []Reference_band{{Orientation: VERTICAL, From: 2, To: 4, Title: "maintenance"}}
+		//	check the result
+		if !reflect.DeepEqual(wantLines, plot.Reference_lines) || !reflect.DeepEqual(wantBands, plot.Reference_bands) {
+			t.Errorf("failed adding references: expected: %v %v result: %v %v", wantLines, wantBands, plot.Reference_lines, plot.Reference_bands)
+		}
+	})
+
+	t.Run(">>> clamp: band limits outside the axis range", func(t *testing.T) {
+
+		scale := newAxisScale(&Axis{}, 0, 10, X_MARGINS, 100)
+
+		want := []float64{X_MARGINS, X_MARGINS + 50, X_MARGINS + 100}
+		got := []float64{clamp(scale.scale(-5), scale), clamp(scale.scale(5), scale), clamp(scale.scale(20), scale)}
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed clamping band limits: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> contains: values in a logarithmic axis", func(t *testing.T) {
+
+		scale := newAxisScale(&Axis{Log_scale: true}, 1, 1000, X_MARGINS, 100)
+
+		want := []bool{false, false, true, false}
+		got := []bool{scale.contains(0), scale.contains(0.5), scale.contains(10), scale.contains(2000)}
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed checking axis range: expected: %v result: %v", want, got)
+		}
+	})
+}
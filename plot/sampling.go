@@ -0,0 +1,227 @@
+////////////////////////////////////////////////////////////////////////////////
+//	sampling.go  -  Oct-19-2026  -  aldebap
+//
+//	Adaptive sampling of the curves of functions, with the detection of their discontinuities
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"math"
+)
+
+//	the intervals between samples are subdivided while the curve bends more than the tolerance, up to a depth where a
+//	step larger than the jump fraction is checked for a discontinuity (both are fractions of the dimension of the curve):
+//	the step is a jump when it's change remains concentrated in one half of the interval as it is bisected
+const (
+	ADAPTIVE_DEPTH     = 6
+	ADAPTIVE_TOLERANCE = 0.001
+	JUMP_FRACTION      = 0.05
+	JUMP_CONCENTRATION = 0.9
+)
+
+//	isFinite check if a value is neither infinite nor NaN
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+//	pathBreak return the point that splits a path into segments
+func pathBreak() Point_2d {
+	return Point_2d{X: math.NaN(), Y: math.NaN()}
+}
+
+//	samplePath sample a curve for evenly spaced values of a parameter from 0 to 1 and subdivide the intervals where
+//	the curve bends: the points where the curve is not finite, as well as it's jumps, are replaced by breaks that
+//	split the path into segments
+func samplePath(samples int, curve func(u float64) (Point_2d, error)) ([]Point_2d, error) {
+
+	if samples < 2 {
+		return nil, errors.New("a function requires at least two samples")
+	}
+
+	evaluate := func(u float64) (Point_2d, error) {
+		point, err := curve(u)
+		if err != nil {
+			return Point_2d{}, err
+		}
+		if !isFinite(point.X) || !isFinite(point.Y) {
+			return pathBreak(), nil
+		}
+
+		return point, nil
+	}
+
+	//	the evenly spaced samples give the dimension of the curve
+	parameter := make([]float64, samples)
+	sample := make([]Point_2d, samples)
+
+	var x_range, y_range axisRange
+
+	for j := range sample {
+		var err error
+
+		parameter[j] = float64(j) / float64(samples-1)
+		sample[j], err = evaluate(parameter[j])
+		if err != nil {
+			return nil, err
+		}
+		if isFinite(sample[j].X) {
+			x_range.extend(sample[j].X, sample[j].X)
+			y_range.extend(sample[j].Y, sample[j].Y)
+		}
+	}
+
+	span_x := x_range.max - x_range.min
+	span_y := y_range.max - y_range.min
+
+	//	the distance between points relative to the dimension of the curve
+	distance := func(p1, p2 Point_2d) float64 {
+		var d_x, d_y float64
+
+		if span_x > 0 {
+			d_x = (p2.X - p1.X) / span_x
+		}
+		if span_y > 0 {
+			d_y = (p2.Y - p1.Y) / span_y
+		}
+
+		return math.Hypot(d_x, d_y)
+	}
+
+	//	isJump check if the step of the curve in an interval doesn't become shorter as the interval is bisected
+	isJump := func(u1 float64, p1 Point_2d, u2 float64, p2 Point_2d) (bool, error) {
+		step := distance(p1, p2)
+
+		for k := 0; k < ADAPTIVE_DEPTH; k++ {
+			u := (u1 + u2) / 2
+
+			middle, err := evaluate(u)
+			if err != nil {
+				return false, err
+			}
+			if !isFinite(middle.X) {
+				return true, nil
+			}
+
+			first, second := distance(p1, middle), distance(middle, p2)
+			if first < JUMP_CONCENTRATION*step && second < JUMP_CONCENTRATION*step {
+				return false, nil
+			}
+
+			if first > second {
+				u2, p2, step = u, middle, first
+			} else {
+				u1, p1, step = u, middle, second
+			}
+		}
+
+		return true, nil
+	}
+
+	var refine func(u1 float64, p1 Point_2d, u2 float64, p2 Point_2d, depth int) ([]Point_2d, error)
+
+	//	refine return the points inside an interval of the parameter
+	refine = func(u1 float64, p1 Point_2d, u2 float64, p2 Point_2d, depth int) ([]Point_2d, error) {
+		finite1, finite2 := isFinite(p1.X), isFinite(p2.X)
+
+		if depth >= ADAPTIVE_DEPTH {
+			if finite1 && finite2 && distance(p1, p2) > JUMP_FRACTION {
+				jump, err := isJump(u1, p1, u2, p2)
+				if err != nil {
+					return nil, err
+				}
+				if jump {
+					return []Point_2d{pathBreak()}, nil
+				}
+			}
+			return nil, nil
+		}
+
+		//	the intervals without the curve are not subdivided
+		if !finite1 && !finite2 {
+			return nil, nil
+		}
+
+		u := (u1 + u2) / 2
+
+		middle, err := evaluate(u)
+		if err != nil {
+			return nil, err
+		}
+
+		//	the intervals where the curve is straight are not subdivided (the limits of the domain of the curve are)
+		if finite1 && finite2 && isFinite(middle.X) {
+			chord := Point_2d{X: (p1.X + p2.X) / 2, Y: (p1.Y + p2.Y) / 2}
+
+			if distance(middle, chord) <= ADAPTIVE_TOLERANCE {
+				return nil, nil
+			}
+		}
+
+		first, err := refine(u1, p1, u, middle, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		second, err := refine(u, middle, u2, p2, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		point := append(first, middle)
+
+		return append(point, second...), nil
+	}
+
+	point := []Point_2d{sample[0]}
+
+	for j := 1; j < samples; j++ {
+		inside, err := refine(parameter[j-1], sample[j-1], parameter[j], sample[j], 0)
+		if err != nil {
+			return nil, err
+		}
+
+		point = append(point, inside...)
+		point = append(point, sample[j])
+	}
+
+	return point, nil
+}
+
+//	pathSegments split the points of a path into the segments between it's breaks
+func pathSegments(point []Point_2d) [][]Point_2d {
+
+	var segment [][]Point_2d
+	var current []Point_2d
+
+	for _, item := range point {
+		if !isFinite(item.X) || !isFinite(item.Y) {
+			if len(current) > 0 {
+				segment = append(segment, current)
+			}
+			current = nil
+			continue
+		}
+
+		current = append(current, item)
+	}
+	if len(current) > 0 {
+		segment = append(segment, current)
+	}
+
+	return segment
+}
+
+//	finitePoints return the points of a path without it's breaks
+func finitePoints(point []Point_2d) []Point_2d {
+
+	result := make([]Point_2d, 0, len(point))
+
+	for _, item := range point {
+		if isFinite(item.X) && isFinite(item.Y) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
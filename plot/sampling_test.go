@@ -0,0 +1,195 @@
+////////////////////////////////////////////////////////////////////////////////
+//	sampling_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the adaptive sampling of functions
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+//	curveOf return the curve of a function of x from min_x to max_x
+func curveOf(function func(x float64) float64, min_x, max_x float64) func(u float64) (Point_2d, error) {
+
+	return func(u float64) (Point_2d, error) {
+		x := min_x + (max_x-min_x)*u
+
+		return Point_2d{X: x, Y: function(x)}, nil
+	}
+}
+
+//	TestSamplePath unit tests for the adaptive sampling of functions
+func TestSamplePath(t *testing.T) {
+
+	t.Run(">>> samplePath: at least two samples", func(t *testing.T) {
+
+		want := "a function requires at least two samples"
+
+		_, err := samplePath(1, curveOf(math.Sin, 0, 1))
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed sampling function: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> samplePath: subdivision of curved intervals", func(t *testing.T) {
+
+		got, err := samplePath(5, curveOf(math.Sin, 0, 2*math.Pi))
+		if err != nil {
+			t.Errorf("fail sampling function: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if len(got) <= 5 || len(pathSegments(got)) != 1 {
+			t.Errorf("failed sampling function: expected a single refined segment result: %d points", len(got))
+			return
+		}
+		for j := 1; j < len(got); j++ {
+			if got[j].X <= got[j-1].X || math.Abs(got[j].Y-math.Sin(got[j].X)) > 1e-9 {
+				t.Errorf("failed sampling function: expected ordered points of the curve result: %v", got[j])
+				return
+			}
+		}
+
+		//	a straight line is not subdivided
+		got, err = samplePath(5, curveOf(func(x float64) float64 { return 2*x + 1 }, 0, 1))
+		if err != nil || len(got) != 5 {
+			t.Errorf("failed sampling function: expected 5 points result: %d points %v", len(got), err)
+		}
+	})
+
+	t.Run(">>> samplePath: poles split the path", func(t *testing.T) {
+
+		got, err := samplePath(100, curveOf(func(x float64) float64 { return 1 / x }, -5, 5))
+		if err != nil {
+			t.Errorf("fail sampling function: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		segment := pathSegments(got)
+		if len(segment) != 2 {
+			t.Errorf("failed sampling function: expected two segments result: %d segments", len(segment))
+			return
+		}
+		for _, point := range segment[0] {
+			if point.X >= 0 {
+				t.Errorf("failed sampling function: expected the left branch in the first segment result: %v", point)
+				return
+			}
+		}
+		for _, point := range segment[1] {
+			if point.X <= 0 {
+				t.Errorf("failed sampling function: expected the right branch in the second segment result: %v", point)
+				return
+			}
+		}
+	})
+
+	t.Run(">>> samplePath: jumps split the path", func(t *testing.T) {
+
+		step := func(x float64) float64 {
+			if x < 0.3 {
+				return -1
+			}
+			return 1
+		}
+
+		got, err := samplePath(10, curveOf(step, -1, 1))
+		if err != nil {
+			t.Errorf("fail sampling function: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		segment := pathSegments(got)
+		if len(segment) != 2 || segment[0][len(segment[0])-1].Y != -1 || segment[1][0].Y != 1 {
+			t.Errorf("failed sampling function: expected two segments at the jump result: %v", segment)
+		}
+	})
+
+	t.Run(">>> samplePath: limits of the domain", func(t *testing.T) {
+
+		got, err := samplePath(8, curveOf(func(x float64) float64 { return math.Sqrt(4 - x*x) }, -3, 3))
+		if err != nil {
+			t.Errorf("fail sampling function: %s", err.Error())
+			return
+		}
+
+		//	check the result: the ends of the segment approach the limits of the domain beyond the samples
+		segment := pathSegments(got)
+		if len(segment) != 1 || segment[0][0].X > -1.95 || segment[0][len(segment[0])-1].X < 1.95 {
+			t.Errorf("failed sampling function: expected a segment from -2 to 2 result: %v", segment)
+		}
+		if len(finitePoints(got)) != len(segment[0]) {
+			t.Errorf("failed sampling function: expected the finite points of the segment result: %d", len(finitePoints(got)))
+		}
+	})
+
+	t.Run(">>> getMinMax: breaks of the path", func(t *testing.T) {
+
+		testSet := &Set_points_2d{
+			Point: []Point_2d{pathBreak(), {X: -1, Y: 2}, pathBreak(), {X: 3, Y: -4}, {X: math.Inf(1), Y: 1}},
+		}
+
+		min_x, min_y, max_x, max_y, err := testSet.getMinMax()
+		//	check the result
+		if err != nil || min_x != -1 || min_y != -4 || max_x != 3 || max_y != 2 {
+			t.Errorf("failed evaluating MinMax: expected: -1, -4, 3, 2 result: %f, %f, %f, %f %v", min_x, min_y, max_x, max_y, err)
+		}
+
+		testSet = &Set_points_2d{Point: []Point_2d{pathBreak()}}
+
+		_, _, _, _, err = testSet.getMinMax()
+		if err == nil {
+			t.Errorf("failed evaluating MinMax: expected an error for a set without finite points")
+		}
+	})
+
+	t.Run(">>> generate: discontinuities in linear and logarithmic axes", func(t *testing.T) {
+
+		for _, log := range []bool{false, true} {
+			var output bytes.Buffer
+
+			writer := bufio.NewWriter(&output)
+			plot := &Plot_2D{
+				Y_axis:   Axis{Log_scale: log, Log_base: 10},
+				Function: []Function_2d{{Title: "tan(x)", Style: LINES, Function: "tan(x)", Min_x: 0, Max_x: 10}},
+			}
+
+			err := plot.generate(NewSVG_Driver(writer, &TerminalOptions{Width: 400, Height: 300}))
+			writer.Flush()
+			if err != nil {
+				t.Errorf("fail generating plot: %s", err.Error())
+				return
+			}
+
+			//	check the result: the poles of tan(x) (and the negative parts in logarithmic scale) split the path
+			if strings.Count(output.String(), "<path") != 4 {
+				t.Errorf("failed generating plot in logarithmic scale %v: expected 4 paths result: %d", log, strings.Count(output.String(), "<path"))
+			}
+		}
+	})
+
+	t.Run(">>> filterLogScale: breaks of the path", func(t *testing.T) {
+
+		set := Set_points_2d{
+			Style: FUNCTION_PATH,
+			Point: []Point_2d{{X: 1, Y: 1}, {X: 2, Y: 2}, pathBreak(), {X: 3, Y: 3}, {X: 4, Y: -1}, {X: 5, Y: 2}, {X: 6, Y: 3}},
+		}
+
+		got := set.filterLogScale(&Axis{}, &Axis{Log_scale: true, Log_base: 10})
+		//	check the result: the break is kept and the negative point becomes a break
+		segment := pathSegments(got.Point)
+		if len(got.Point) != len(set.Point) || len(segment) != 3 || len(segment[0]) != 2 || len(segment[1]) != 1 || len(segment[2]) != 2 {
+			t.Errorf("failed filtering points in logarithmic scale: expected 3 segments result: %v", segment)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+////////////////////////////////////////////////////////////////////////////////
+//	statistics.go  -  Oct-19-2026  -  aldebap
+//
+//	Statistics of samples used by box-and-whisker and violin plots
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+//	statistics of a sample shown by a box-and-whisker plot
+type Box_statistics struct {
+	Median         float64
+	Lower_quartile float64
+	Upper_quartile float64
+	Lower_whisker  float64
+	Upper_whisker  float64
+	Outliers       []float64
+}
+
+//	BoxStatistics compute the quartiles of a sample, with whiskers reaching the farthest values within
+//	the range (in interquartile distances) from the box, and the values beyond them as outliers
+func BoxStatistics(sample []float64, whiskerRange float64) (*Box_statistics, error) {
+
+	if len(sample) == 0 {
+		return nil, errors.New("no values in the sample")
+	}
+	if whiskerRange < 0 {
+		return nil, errors.New("whisker range expected to be non-negative")
+	}
+
+	sorted := append([]float64(nil), sample...)
+	sort.Float64s(sorted)
+
+	statistics := &Box_statistics{
+		Median:         quantile(sorted, 0.5),
+		Lower_quartile: quantile(sorted, 0.25),
+		Upper_quartile: quantile(sorted, 0.75),
+	}
+
+	interquartile := statistics.Upper_quartile - statistics.Lower_quartile
+	lowerLimit := statistics.Lower_quartile - whiskerRange*interquartile
+	upperLimit := statistics.Upper_quartile + whiskerRange*interquartile
+
+	statistics.Lower_whisker = statistics.Lower_quartile
+	statistics.Upper_whisker = statistics.Upper_quartile
+
+	for _, value := range sorted {
+		if value < lowerLimit || value > upperLimit {
+			statistics.Outliers = append(statistics.Outliers, value)
+			continue
+		}
+
+		if value < statistics.Lower_whisker {
+			statistics.Lower_whisker = value
+		}
+		if value > statistics.Upper_whisker {
+			statistics.Upper_whisker = value
+		}
+	}
+
+	return statistics, nil
+}
+
+//	quantile return the quantile of a sorted sample, interpolating between the closest values
+func quantile(sorted []float64, q float64) float64 {
+
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+
+	return sorted[lower] + (position-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+//	SilvermanBandwidth return the bandwidth of a gaussian kernel density estimate by Silverman's rule of thumb
+func SilvermanBandwidth(sample []float64) float64 {
+
+	if len(sample) < 2 {
+		return 0
+	}
+
+	var mean, variance float64
+
+	for _, value := range sample {
+		mean += value
+	}
+	mean /= float64(len(sample))
+
+	for _, value := range sample {
+		variance += (value - mean) * (value - mean)
+	}
+	deviation := math.Sqrt(variance / float64(len(sample)-1))
+
+	sorted := append([]float64(nil), sample...)
+	sort.Float64s(sorted)
+
+	//	the spread is the smallest of the standard deviation and the normalized interquartile distance
+	spread := deviation
+	if interquartile := (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / 1.34; interquartile > 0 && interquartile < spread {
+		spread = interquartile
+	}
+
+	return 0.9 * spread * math.Pow(float64(len(sample)), -0.2)
+}
+
+//	KernelDensity evaluate the gaussian kernel density estimate of a sample at each of the informed values
+func KernelDensity(sample []float64, bandwidth float64, value []float64) ([]float64, error) {
+
+	if len(sample) == 0 {
+		return nil, errors.New("no values in the sample")
+	}
+	if bandwidth <= 0 {
+		return nil, errors.New("kernel bandwidth expected to be positive")
+	}
+
+	density := make([]float64, len(value))
+	factor := 1 / (float64(len(sample)) * bandwidth * math.Sqrt(2*math.Pi))
+
+	for i := range value {
+		for _, point := range sample {
+			u := (value[i] - point) / bandwidth
+			density[i] += math.Exp(-u * u / 2)
+		}
+		density[i] *= factor
+	}
+
+	return density, nil
+}
@@ -0,0 +1,94 @@
+////////////////////////////////////////////////////////////////////////////////
+//	statistics_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the statistics of samples
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+//	TestStatistics unit tests for the statistics of samples
+func TestStatistics(t *testing.T) {
+
+	t.Run(">>> BoxStatistics: quartiles, whiskers and outliers", func(t *testing.T) {
+
+		got, err := BoxStatistics([]float64{7, 1, 3, 2, 5, 4, 6, 30, 8, -20}, 1.5)
+		if err != nil {
+			t.Errorf("fail computing the statistics: %s", err.Error())
+			return
+		}
+
+		want := &Box_statistics{
+			Median:         4.5,
+			Lower_quartile: 2.25,
+			Upper_quartile: 6.75,
+			Lower_whisker:  1,
+			Upper_whisker:  8,
+			Outliers:       []float64{-20, 30},
+		}
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed computing the statistics: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> BoxStatistics: empty sample", func(t *testing.T) {
+		want := "no values in the sample"
+
+		_, err := BoxStatistics(nil, 1.5)
+		//	check the result
+		if err == nil || want != err.Error() {
+			t.Errorf("failed computing the statistics: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> BoxStatistics: whisker range", func(t *testing.T) {
+
+		//	a zero range is valid: the whiskers are at the quartiles
+		got, err := BoxStatistics([]float64{1, 2, 3, 4, 5}, 0)
+		//	check the result
+		if err != nil || got.Lower_whisker != 2 || got.Upper_whisker != 4 || len(got.Outliers) != 2 {
+			t.Errorf("failed computing the statistics: expected whiskers at the quartiles result: %v %v", got, err)
+		}
+
+		want := "whisker range expected to be non-negative"
+
+		_, err = BoxStatistics([]float64{1, 2, 3}, -1)
+		if err == nil || want != err.Error() {
+			t.Errorf("failed computing the statistics: expected error: %s result: %v", want, err)
+		}
+	})
+
+	t.Run(">>> KernelDensity: density of a single value", func(t *testing.T) {
+
+		got, err := KernelDensity([]float64{0}, 1, []float64{0, 1})
+		if err != nil {
+			t.Errorf("fail computing the density: %s", err.Error())
+			return
+		}
+
+		want := []float64{1 / math.Sqrt(2*math.Pi), math.Exp(-0.5) / math.Sqrt(2*math.Pi)}
+		//	check the result
+		for i := range want {
+			if math.Abs(want[i]-got[i]) > 1e-12 {
+				t.Errorf("failed computing the density: expected: %v result: %v", want, got)
+				break
+			}
+		}
+	})
+
+	t.Run(">>> SilvermanBandwidth: sample without spread", func(t *testing.T) {
+
+		want := 0.0
+		got := SilvermanBandwidth([]float64{3, 3, 3})
+		//	check the result
+		if want != got {
+			t.Errorf("failed computing the bandwidth: expected: %f result: %f", want, got)
+		}
+	})
+}
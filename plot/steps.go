@@ -0,0 +1,96 @@
+////////////////////////////////////////////////////////////////////////////////
+//	steps.go  -  Oct-19-2026  -  aldebap
+//
+//	Impulses and step styles of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+//	stepPath return the vertices of the step function described by the points of the set:
+//	steps go horizontal and then vertical, fsteps go vertical first and histeps are centered in each x
+func (set *Set_points_2d) stepPath() []Point_2d {
+
+	if len(set.Point) == 0 {
+		return nil
+	}
+
+	point := set.Point
+	path := make([]Point_2d, 0, 2*len(point))
+
+	switch set.Style {
+	case FSTEPS:
+		path = append(path, Point_2d{X: point[0].X, Y: point[0].Y})
+		for i := 1; i < len(point); i++ {
+			path = append(path, Point_2d{X: point[i-1].X, Y: point[i].Y}, Point_2d{X: point[i].X, Y: point[i].Y})
+		}
+
+	case HISTEPS:
+		//	the step of each point goes from the middle of the previous interval to the middle of the next one
+		halfInterval := 0.5
+		if len(point) > 1 {
+			halfInterval = (point[1].X - point[0].X) / 2
+		}
+		path = append(path, Point_2d{X: point[0].X - halfInterval, Y: point[0].Y})
+
+		for i := 1; i < len(point); i++ {
+			middle := (point[i-1].X + point[i].X) / 2
+			path = append(path, Point_2d{X: middle, Y: point[i-1].Y}, Point_2d{X: middle, Y: point[i].Y})
+		}
+
+		last := len(point) - 1
+		if last > 0 {
+			halfInterval = (point[last].X - point[last-1].X) / 2
+		}
+		path = append(path, Point_2d{X: point[last].X + halfInterval, Y: point[last].Y})
+
+	default:
+		path = append(path, Point_2d{X: point[0].X, Y: point[0].Y})
+		for i := 1; i < len(point); i++ {
+			path = append(path, Point_2d{X: point[i].X, Y: point[i-1].Y}, Point_2d{X: point[i].X, Y: point[i].Y})
+		}
+	}
+
+	return path
+}
+
+//	generateSteps generate the path of a step function, filling the area down to the origin of the y axis for fillsteps
+func (set *Set_points_2d) generateSteps(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	path := set.stepPath()
+	if len(path) == 0 {
+		return
+	}
+
+	if set.Style == FILLSTEPS {
+		polygon := make([]DriverPoint, 0, len(path)+2)
+		origin := int64(clamp(y_scale.scale(y_scale.origin()), y_scale))
+
+		polygon = append(polygon, DriverPoint{X: int64(x_scale.scale(path[0].X)), Y: origin})
+		for _, point := range path {
+			polygon = append(polygon, DriverPoint{X: int64(x_scale.scale(point.X)), Y: int64(clamp(y_scale.scale(point.Y), y_scale))})
+		}
+		polygon = append(polygon, DriverPoint{X: int64(x_scale.scale(path[len(path)-1].X)), Y: origin})
+
+		if len(path) > 1 {
+			driver.FillPolygon(polygon, colour, set.Fill)
+		}
+	}
+
+	driver.BeginPath(colour)
+	for _, point := range path {
+		driver.PointToPath(int64(x_scale.scale(point.X)), int64(y_scale.scale(point.Y)))
+	}
+	driver.EndPath()
+}
+
+//	generateImpulses generate a vertical line from the origin of the y axis to each point of the set
+func (set *Set_points_2d) generateImpulses(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	origin := int64(clamp(y_scale.scale(y_scale.origin()), y_scale))
+
+	for _, point := range set.Point {
+		scaled_x := int64(x_scale.scale(point.X))
+
+		driver.Line(scaled_x, origin, scaled_x, int64(y_scale.scale(point.Y)), colour)
+	}
+}
@@ -0,0 +1,76 @@
+////////////////////////////////////////////////////////////////////////////////
+//	steps_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the impulses and step styles
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//	TestStepPath unit tests for stepPath()
+func TestStepPath(t *testing.T) {
+
+	point := []Point_2d{{X: 0, Y: 1}, {X: 2, Y: 3}, {X: 4, Y: 2}}
+
+	t.Run(">>> stepPath: steps", func(t *testing.T) {
+
+		set := Set_points_2d{Style: STEPS, Point: point}
+
+		want := []Point_2d{{X: 0, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 3}, {X: 4, Y: 3}, {X: 4, Y: 2}}
+		got := set.stepPath()
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed generating steps: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> stepPath: fsteps", func(t *testing.T) {
+
+		set := Set_points_2d{Style: FSTEPS, Point: point}
+
+		want := []Point_2d{{X: 0, Y: 1}, {X: 0, Y: 3}, {X: 2, Y: 3}, {X: 2, Y: 2}, {X: 4, Y: 2}}
+		got := set.stepPath()
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed generating fsteps: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> stepPath: histeps", func(t *testing.T) {
+
+		set := Set_points_2d{Style: HISTEPS, Point: point}
+
+		want := []Point_2d{{X: -1, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 3}, {X: 3, Y: 3}, {X: 3, Y: 2}, {X: 5, Y: 2}}
+		got := set.stepPath()
+		//	check the result
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("failed generating histeps: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> generateImpulses: lines from the origin", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set := Set_points_2d{Style: IMPULSES, Point: []Point_2d{{X: 5, Y: -5}}}
+		set.generateImpulses(driver, newAxisScale(&Axis{}, 0, 10, 0, 100), newAxisScale(&Axis{}, -10, 10, 0, 100), RED)
+		writer.Flush()
+
+		want := `<line x1="50" y1="50" x2="50" y2="75"`
+		//	check the result
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("failed generating impulses: expected: %s result: %s", want, output.String())
+		}
+	})
+}
@@ -10,34 +10,45 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"html"
+	"strings"
 )
 
 type SVG_Driver struct {
 	writer     *bufio.Writer
 	width      int64
 	height     int64
+	background RGB_colour
+	dynamic    bool
 	path       []DriverPoint
 	pathColour RGB_colour
+	lineWidth  float64
+	dashType   uint8
 	fontFamily string
 	fontSize   uint8
+	patterns   int
 }
 
 //	create a new SVG_Driver
-func NewSVG_Driver(writer *bufio.Writer) GraphicsDriver {
+func NewSVG_Driver(writer *bufio.Writer, options *TerminalOptions) GraphicsDriver {
 	const (
-		WIDTH       = 640
-		HEIGHT      = 480
-		FONT_FAMILY = "Verdana"
-		FONT_SIZE   = 10
+		WIDTH  = 640
+		HEIGHT = 480
 	)
 
+	width, height := options.dimensions(WIDTH, HEIGHT)
+
 	return &SVG_Driver{
 		writer:     writer,
-		width:      WIDTH,
-		height:     HEIGHT,
+		width:      width,
+		height:     height,
+		background: options.background(),
+		dynamic:    options != nil && options.Dynamic,
 		path:       nil,
-		fontFamily: FONT_FAMILY,
-		fontSize:   FONT_SIZE,
+		lineWidth:  DEFAULT_LINE_WIDTH,
+		dashType:   DASH_SOLID,
+		fontFamily: options.fontFamily(),
+		fontSize:   options.fontSize(),
 	}
 }
 
@@ -51,12 +62,18 @@ func (driver *SVG_Driver) SetDimensions(width int64, height int64) error {
 	driver.width = width
 	driver.height = height
 
-	driver.writer.WriteString("<svg width=\"" + fmt.Sprintf("%d", width) + "\" height=\"" + fmt.Sprintf("%d", height) + "\" " +
-		"xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n")
+	//	a dynamic SVG scales to the size of it's container
+	if driver.dynamic {
+		driver.writer.WriteString("<svg viewBox=\"0 0 " + fmt.Sprintf("%d", width) + " " + fmt.Sprintf("%d", height) + "\" " +
+			"xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n")
+	} else {
+		driver.writer.WriteString("<svg width=\"" + fmt.Sprintf("%d", width) + "\" height=\"" + fmt.Sprintf("%d", height) + "\" " +
+			"xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n")
+	}
 
 	driver.Comment("image background")
 	driver.writer.WriteString("<rect width=\"" + fmt.Sprintf("%d", width) + "\" height=\"" + fmt.Sprintf("%d", height) + "\" " +
-		"style=\"fill:white;stroke-width:0\" />\n")
+		"style=\"fill:#" + driver.background.Hexa() + ";stroke-width:0\" />\n")
 
 	return nil
 }
@@ -74,6 +91,35 @@ func (driver *SVG_Driver) SetFont(fontFamily string, fontSize uint8) error {
 	return nil
 }
 
+//	SetLineStyle set the width and dash type used to draw lines and paths
+func (driver *SVG_Driver) SetLineStyle(width float64, dashType uint8) error {
+	if width <= 0 {
+		return errors.New("line width expected to be positive")
+	}
+	driver.lineWidth = width
+	driver.dashType = dashType
+
+	return nil
+}
+
+//	strokeStyle return the style attribute to draw lines using the current line style
+func (driver *SVG_Driver) strokeStyle(colour RGB_colour) string {
+	style := "stroke:rgb(" + fmt.Sprintf("%d", colour.red) +
+		"," + fmt.Sprintf("%d", colour.green) +
+		"," + fmt.Sprintf("%d", colour.blue) + ");stroke-width:" + fmt.Sprintf("%g", driver.lineWidth)
+
+	pattern := dashPattern(driver.dashType, driver.lineWidth)
+	if len(pattern) > 0 {
+		dashes := make([]string, len(pattern))
+		for i, length := range pattern {
+			dashes[i] = fmt.Sprintf("%g", length)
+		}
+		style += ";stroke-dasharray:" + strings.Join(dashes, ",")
+	}
+
+	return style
+}
+
 //	Comment write a comment int the the SVG graphic
 func (driver *SVG_Driver) Comment(text string) {
 	driver.writer.WriteString("<!-- " + text + "-->\n")
@@ -115,9 +161,7 @@ func (driver *SVG_Driver) EndPath() error {
 		return errors.New("cannot end a path not initialized")
 	}
 
-	style := "stroke:rgb(" + fmt.Sprintf("%d", driver.pathColour.red) +
-		"," + fmt.Sprintf("%d", driver.pathColour.green) +
-		"," + fmt.Sprintf("%d", driver.pathColour.blue) + ");stroke-width:1"
+	style := driver.strokeStyle(driver.pathColour)
 
 	for i, point := range driver.path {
 		if i == 0 {
@@ -134,9 +178,7 @@ func (driver *SVG_Driver) EndPath() error {
 
 //	Line draws a line between two points in the SVG graphic
 func (driver *SVG_Driver) Line(x1, y1, x2, y2 int64, colour RGB_colour) error {
-	style := "stroke:rgb(" + fmt.Sprintf("%d", colour.red) +
-		"," + fmt.Sprintf("%d", colour.green) +
-		"," + fmt.Sprintf("%d", colour.blue) + ");stroke-width:1"
+	style := driver.strokeStyle(colour)
 
 	driver.writer.WriteString("<line x1=\"" + fmt.Sprintf("%d", x1) + "\" y1=\"" + fmt.Sprintf("%d", driver.height-y1) + "\" " +
 		"x2=\"" + fmt.Sprintf("%d", x2) + "\" y2=\"" + fmt.Sprintf("%d", driver.height-y2) + "\" style=\"" + style + "\" />\n")
@@ -144,6 +186,120 @@ func (driver *SVG_Driver) Line(x1, y1, x2, y2 int64, colour RGB_colour) error {
 	return nil
 }
 
+//	Polygon draws an open or filled polygon in the SVG graphic
+func (driver *SVG_Driver) Polygon(point []DriverPoint, colour RGB_colour, filled bool) error {
+	if len(point) < 3 {
+		return errors.New("not enough points to draw a polygon")
+	}
+
+	coordinates := make([]string, len(point))
+	for i, vertex := range point {
+		coordinates[i] = fmt.Sprintf("%d", vertex.X) + "," + fmt.Sprintf("%d", driver.height-vertex.Y)
+	}
+
+	driver.writer.WriteString("<polygon points=\"" + strings.Join(coordinates, " ") + "\" style=\"" +
+		driver.shapeStyle(colour, filled) + "\" />\n")
+
+	return nil
+}
+
+//	FillPolygon draws the interior of a polygon with a fill style in the SVG graphic
+func (driver *SVG_Driver) FillPolygon(point []DriverPoint, colour RGB_colour, fill Fill_style) error {
+	if len(point) < 3 {
+		return errors.New("not enough points to draw a polygon")
+	}
+
+	coordinates := make([]string, len(point))
+	for i, vertex := range point {
+		coordinates[i] = fmt.Sprintf("%d", vertex.X) + "," + fmt.Sprintf("%d", driver.height-vertex.Y)
+	}
+
+	var style string
+
+	switch fill.effectiveType() {
+	case FILL_EMPTY:
+		return nil
+
+	case FILL_PATTERN:
+		//	the pattern's tile is defined before the polygon, which is painted with the background when not transparent
+		lines := fill.lines()
+		driver.patterns++
+
+		id := "pattern" + fmt.Sprintf("%d", driver.patterns)
+		var tile []string
+
+		for _, segment := range lines.segments() {
+			tile = append(tile, fmt.Sprintf("M%d,%d L%d,%d", segment[0], segment[1], segment[2], segment[3]))
+		}
+
+		driver.writer.WriteString("<defs><pattern id=\"" + id + "\" patternUnits=\"userSpaceOnUse\" width=\"" +
+			fmt.Sprintf("%d", lines.spacing) + "\" height=\"" + fmt.Sprintf("%d", lines.spacing) + "\">" +
+			"<path d=\"" + strings.Join(tile, " ") + "\" style=\"stroke:#" + colour.Hexa() + ";stroke-width:1\" />" +
+			"</pattern></defs>\n")
+
+		if !fill.Transparent {
+			driver.writer.WriteString("<polygon points=\"" + strings.Join(coordinates, " ") + "\" style=\"" +
+				driver.shapeStyle(driver.background, true) + "\" />\n")
+		}
+		style = "fill:url(#" + id + ");stroke:none"
+
+	default:
+		solidColour := fill.solidColour(colour, driver.background)
+
+		style = "fill:#" + solidColour.Hexa() + ";stroke:none"
+		if fill.Transparent {
+			style += ";fill-opacity:" + fmt.Sprintf("%g", fill.opacity())
+		}
+	}
+
+	driver.writer.WriteString("<polygon points=\"" + strings.Join(coordinates, " ") + "\" style=\"" + style + "\" />\n")
+
+	return nil
+}
+
+//	Circle draws an open or filled circle in the SVG graphic
+func (driver *SVG_Driver) Circle(x, y, radius int64, colour RGB_colour, filled bool) error {
+	driver.writer.WriteString("<circle cx=\"" + fmt.Sprintf("%d", x) + "\" cy=\"" + fmt.Sprintf("%d", driver.height-y) + "\" " +
+		"r=\"" + fmt.Sprintf("%d", radius) + "\" style=\"" + driver.shapeStyle(colour, filled) + "\" />\n")
+
+	return nil
+}
+
+//	Image draws a raster of colours stretched into a rectangle, as a grid of rectangles in the SVG graphic
+func (driver *SVG_Driver) Image(x, y, width, height int64, pixel [][]RGB_colour) error {
+	if len(pixel) == 0 || width <= 0 || height <= 0 {
+		return errors.New("not enough pixels to draw an image")
+	}
+
+	driver.writer.WriteString("<g shape-rendering=\"crispEdges\">\n")
+
+	for j, row := range pixel {
+		y1 := rasterEdge(y, height, j, len(pixel))
+		y2 := rasterEdge(y, height, j+1, len(pixel))
+
+		for i, colour := range row {
+			x1 := rasterEdge(x, width, i, len(row))
+			x2 := rasterEdge(x, width, i+1, len(row))
+
+			driver.writer.WriteString("<rect x=\"" + fmt.Sprintf("%d", x1) + "\" y=\"" + fmt.Sprintf("%d", driver.height-y2) + "\" " +
+				"width=\"" + fmt.Sprintf("%d", x2-x1) + "\" height=\"" + fmt.Sprintf("%d", y2-y1) + "\" style=\"" + driver.shapeStyle(colour, true) + "\" />\n")
+		}
+	}
+
+	driver.writer.WriteString("</g>\n")
+
+	return nil
+}
+
+//	shapeStyle return the style attribute to draw the outline or the interior of a shape
+func (driver *SVG_Driver) shapeStyle(colour RGB_colour, filled bool) string {
+	if filled {
+		return "fill:#" + colour.Hexa() + ";stroke:none"
+	}
+
+	return "fill:none;" + driver.strokeStyle(colour)
+}
+
 //	GetTextBox evaluate the width and height of the rectangle required to draw the text string using a given font size
 func (driver *SVG_Driver) GetTextBox(text string) (width, height int64) {
 
@@ -162,13 +318,13 @@ func (driver *SVG_Driver) Text(x, y, angle int64, text string, colour RGB_colour
 
 	if angle == 0 {
 		driver.writer.WriteString("<text x=\"" + fmt.Sprintf("%d", x) + "\" y=\"" + fmt.Sprintf("%d", driver.height-y) + "\" " +
-			"style=\"" + style + "\" font-family=\"" + driver.fontFamily + "\" font-size=\"" + fmt.Sprintf("%d", driver.fontSize) +
+			"style=\"" + style + "\" font-family=\"" + html.EscapeString(driver.fontFamily) + "\" font-size=\"" + fmt.Sprintf("%d", driver.fontSize) +
 			"\">" + text + "</text>\n")
 	} else {
 		driver.writer.WriteString("<text x=\"" + fmt.Sprintf("%d", x) + "\" y=\"" + fmt.Sprintf("%d", driver.height-y) + "\" " +
 			"transform=\"rotate(" + fmt.Sprintf("%d", angle) + ", " +
 			fmt.Sprintf("%d", x) + ", " + fmt.Sprintf("%d", driver.height-y) + ")\" " +
-			"style=\"" + style + "\" font-family=\"" + driver.fontFamily + "\" font-size=\"" + fmt.Sprintf("%d", driver.fontSize) +
+			"style=\"" + style + "\" font-family=\"" + html.EscapeString(driver.fontFamily) + "\" font-size=\"" + fmt.Sprintf("%d", driver.fontSize) +
 			"\">" + text + "</text>\n")
 	}
 
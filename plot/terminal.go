@@ -0,0 +1,110 @@
+////////////////////////////////////////////////////////////////////////////////
+//	terminal.go  -  Oct-19-2026  -  aldebap
+//
+//	Options for the terminals used to generate a plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"errors"
+	"regexp"
+)
+
+//	default values for terminal options
+const (
+	DEFAULT_FONT_FAMILY  = "Verdana"
+	DEFAULT_FONT_SIZE    = 10
+	DEFAULT_JPEG_QUALITY = 75
+	DEFAULT_CANVAS_NAME  = "canvas_plot"
+)
+
+//	options of a terminal (zero values means driver's default)
+type TerminalOptions struct {
+	Width       int64
+	Height      int64
+	Font_family string
+	Font_size   uint8
+	Background  *RGB_colour
+	Quality     int
+	Dynamic     bool
+	Name        string
+}
+
+//	the name of a canvas is used as the name of a JavaScript function and as the id of the canvas element
+var canvasNameRegEx = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+//	ValidateCanvasName check if a name can be used as the name of a canvas
+func ValidateCanvasName(name string) error {
+	if !canvasNameRegEx.MatchString(name) {
+		return errors.New("invalid canvas name: " + name)
+	}
+
+	return nil
+}
+
+//	newGraphicsDriver create the graphics driver for a terminal
+func newGraphicsDriver(terminal uint8, options *TerminalOptions, plotWriter *bufio.Writer) GraphicsDriver {
+
+	switch terminal {
+	case TERMINAL_CANVAS:
+		return NewCanvas_Driver(plotWriter, options)
+
+	case TERMINAL_GIF:
+		return NewGIF_Driver(plotWriter, options)
+
+	case TERMINAL_JPEG:
+		return NewJPEG_Driver(plotWriter, options)
+
+	case TERMINAL_PNG:
+		return NewPNG_Driver(plotWriter, options)
+
+	case TERMINAL_SVG:
+		return NewSVG_Driver(plotWriter, options)
+	}
+
+	return NewSVG_Driver(plotWriter, options)
+}
+
+//	fontFamily return the font family from options or the default one
+func (options *TerminalOptions) fontFamily() string {
+	if options == nil || len(options.Font_family) == 0 {
+		return DEFAULT_FONT_FAMILY
+	}
+
+	return options.Font_family
+}
+
+//	fontSize return the font size from options or the default one
+func (options *TerminalOptions) fontSize() uint8 {
+	if options == nil || options.Font_size == 0 {
+		return DEFAULT_FONT_SIZE
+	}
+
+	return options.Font_size
+}
+
+//	dimensions return the dimensions from options or the default ones
+func (options *TerminalOptions) dimensions(defaultWidth, defaultHeight int64) (width, height int64) {
+	width = defaultWidth
+	height = defaultHeight
+
+	if options != nil && options.Width > 0 {
+		width = options.Width
+	}
+	if options != nil && options.Height > 0 {
+		height = options.Height
+	}
+
+	return width, height
+}
+
+//	background return the background colour from options or white
+func (options *TerminalOptions) background() RGB_colour {
+	if options == nil || options.Background == nil {
+		return WHITE
+	}
+
+	return *options.Background
+}
@@ -0,0 +1,72 @@
+////////////////////////////////////////////////////////////////////////////////
+//	terminal_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the options of the terminals
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestTerminalOptions unit tests for the options of the terminals
+func TestTerminalOptions(t *testing.T) {
+
+	t.Run(">>> ValidateCanvasName: JavaScript identifiers", func(t *testing.T) {
+
+		for _, name := range []string{"myplot", "_plot2", "$canvas", "Plot_1"} {
+			err := ValidateCanvasName(name)
+			//	check the result
+			if err != nil {
+				t.Errorf("failed validating canvas name %s: %s", name, err.Error())
+			}
+		}
+
+		for _, name := range []string{"", "2plot", "my plot", "plot\"", "f(){alert(1)}", "a-b"} {
+			want := "invalid canvas name: " + name
+
+			err := ValidateCanvasName(name)
+			if err == nil || want != err.Error() {
+				t.Errorf("failed validating canvas name %s: expected error: %s result: %v", name, want, err)
+			}
+		}
+	})
+
+	t.Run(">>> NewCanvas_Driver: invalid name and escaped font", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewCanvas_Driver(writer, &TerminalOptions{Name: "x(){}", Font_family: "Verdana\";alert(1);\""})
+		driver.SetDimensions(100, 100)
+		driver.Text(10, 10, 0, "</script>", BLACK)
+		writer.Flush()
+
+		//	check the result
+		if !strings.Contains(output.String(), "function "+DEFAULT_CANVAS_NAME+"() {") ||
+			!strings.Contains(output.String(), "px Verdana\\\";alert(1);\\\"\";") ||
+			strings.Contains(output.String(), "</script>") {
+			t.Errorf("failed generating canvas: expected the default name and escaped strings result: %s", output.String())
+		}
+	})
+
+	t.Run(">>> NewSVG_Driver: escaped font family", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Font_family: "Verdana\" onload=\"alert(1)"})
+		driver.SetDimensions(100, 100)
+		driver.Text(10, 10, 0, "label", BLACK)
+		writer.Flush()
+
+		//	check the result
+		if !strings.Contains(output.String(), "font-family=\"Verdana&#34; onload=&#34;alert(1)\"") {
+			t.Errorf("failed generating svg: expected an escaped font family result: %s", output.String())
+		}
+	})
+}
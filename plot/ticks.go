@@ -0,0 +1,390 @@
+////////////////////////////////////////////////////////////////////////////////
+//	ticks.go  -  Oct-19-2026  -  aldebap
+//
+//	Generation and formatting of the tick marks of an axis
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//	special values for the number of minor tics of an axis
+const (
+	MINOR_TICS_DEFAULT = 0
+	MINOR_TICS_AUTO    = -1
+	MINOR_TICS_OFF     = -2
+)
+
+const (
+	DEFAULT_TICK_FORMAT = "%g"
+	TICK_EPSILON        = 1e-9
+	MAX_TICKS           = 1000
+)
+
+//	explicit series of tics (start -Inf means multiples of increment, end +Inf means until the end of the axis)
+type Tics_series struct {
+	Start     float64
+	Increment float64
+	End       float64
+}
+
+//	explicitIncrement return the increment of the series, unless it would generate too many ticks from min to max
+func (tics *Tics_series) explicitIncrement(min, max float64) float64 {
+	if tics == nil || tics.Increment <= 0 || (max-min)/tics.Increment > MAX_TICKS {
+		return 0
+	}
+
+	return tics.Increment
+}
+
+//	tic with an explicit label
+type Tic_label struct {
+	Label string
+	Value float64
+}
+
+//	tick mark in an axis
+type axisTick struct {
+	value float64
+	label string
+	minor bool
+}
+
+//	niceStep round an interval to 1, 2 or 5 times a power of 10
+func niceStep(interval float64) float64 {
+
+	if interval <= 0 || math.IsInf(interval, 0) || math.IsNaN(interval) {
+		return 1
+	}
+
+	magnitude := math.Pow(10, math.Floor(math.Log10(interval)))
+	normalized := interval / magnitude
+
+	switch {
+	case normalized < 1.5:
+		return magnitude
+
+	case normalized < 3:
+		return 2 * magnitude
+
+	case normalized < 7:
+		return 5 * magnitude
+	}
+
+	return 10 * magnitude
+}
+
+//	ticks generate the tick marks for the axis
+func (s *axisScale) ticks(minDivisions, maxDivisions int64) []axisTick {
+
+	var axis Axis
+
+	if s.axis != nil {
+		axis = *s.axis
+	}
+
+	format := axis.Format
+	if len(format) == 0 {
+		format = DEFAULT_TICK_FORMAT
+	}
+
+	//	labelled tics replace the automatic ones
+	if len(axis.Tic_labels) > 0 {
+		tick := make([]axisTick, 0, len(axis.Tic_labels))
+
+		for _, ticLabel := range axis.Tic_labels {
+			if !s.inRange(ticLabel.Value) {
+				continue
+			}
+
+			label := ticLabel.Label
+			if len(label) == 0 {
+				label, _ = formatTick(format, ticLabel.Value)
+			}
+
+			tick = append(tick, axisTick{
+				value: ticLabel.Value,
+				label: label,
+			})
+		}
+
+		return tick
+	}
+
+	var tick []axisTick
+
+	if s.log {
+		tick = s.logTicks(axis.Minor_tics)
+	} else {
+		tick = s.linearTicks(axis.Tics, axis.Minor_tics, minDivisions, maxDivisions)
+	}
+
+	for i := range tick {
+		if !tick[i].minor {
+			tick[i].label, _ = formatTick(format, tick[i].value)
+		}
+	}
+
+	return tick
+}
+
+//	inRange check if a value is in the axis range
+func (s *axisScale) inRange(value float64) bool {
+	tolerance := TICK_EPSILON * math.Abs(s.max-s.min)
+
+	return value >= s.min-tolerance && value <= s.max+tolerance
+}
+
+//	linearTicks generate the tick marks for a linear axis
+func (s *axisScale) linearTicks(tics *Tics_series, minorTics int, minDivisions, maxDivisions int64) []axisTick {
+
+	//	choose the increment between major ticks
+	var step float64
+	var start float64 = math.Inf(-1)
+	var end float64 = math.Inf(1)
+
+	//	an increment too small for the range is replaced by the automatic one
+	if increment := tics.explicitIncrement(s.min, s.max); increment > 0 {
+		step = increment
+		start = tics.Start
+		end = tics.End
+	} else {
+		step = niceStep((s.max - s.min) / float64(minDivisions))
+		if (s.max-s.min)/step > float64(maxDivisions) {
+			step = niceStep((s.max - s.min) / float64(maxDivisions))
+		}
+	}
+
+	//	a range or increment that is not finite would never reach the end of the axis
+	if !isFinite(s.min) || !isFinite(s.max) || !isFinite(step) || s.max <= s.min || step <= 0 {
+		return []axisTick{}
+	}
+
+	//	when there's no explicit start, ticks are placed at multiples of the increment
+	explicitStart := !math.IsInf(start, -1)
+
+	if !explicitStart || start < s.min {
+		first := math.Ceil(s.min/step - TICK_EPSILON)
+
+		if explicitStart {
+			first = start/step + math.Ceil((s.min-start)/step-TICK_EPSILON)
+		}
+		start = first * step
+	}
+	if end > s.max {
+		end = s.max
+	}
+
+	//	number of minor intervals between major ticks
+	minorIntervals := 1
+
+	switch {
+	case minorTics > 0:
+		minorIntervals = minorTics
+
+	case minorTics == MINOR_TICS_AUTO:
+		minorIntervals = 5
+		if mantissa := step / math.Pow(10, math.Floor(math.Log10(step))); math.Abs(mantissa-2) < TICK_EPSILON {
+			minorIntervals = 4
+		}
+	}
+	if (s.max-s.min)/step*float64(minorIntervals) > MAX_TICKS {
+		minorIntervals = 1
+	}
+
+	tick := make([]axisTick, 0)
+	tolerance := TICK_EPSILON * math.Abs(s.max-s.min)
+
+	for i := -1; ; i++ {
+		value := roundToStep(start+float64(i)*step, step/float64(minorIntervals))
+
+		//	avoid labels like "-1.2e-16" for zero
+		if math.Abs(value) < tolerance {
+			value = 0
+		}
+		if value > s.max+tolerance {
+			break
+		}
+
+		if i >= 0 && value <= end+tolerance {
+			tick = append(tick, axisTick{value: value})
+		}
+
+		//	minor ticks are placed only after an explicit start
+		if i < 0 && explicitStart {
+			continue
+		}
+
+		for j := 1; j < minorIntervals; j++ {
+			minorValue := roundToStep(value+float64(j)*step/float64(minorIntervals), step/float64(minorIntervals))
+
+			if s.inRange(minorValue) && minorValue <= end+tolerance {
+				tick = append(tick, axisTick{value: minorValue, minor: true})
+			}
+		}
+	}
+
+	return tick
+}
+
+//	roundToStep round a value to the decimal digits of a step to avoid labels like "0.6000000000000001"
+func roundToStep(value, step float64) float64 {
+
+	decimals := 1 - math.Floor(math.Log10(step))
+	if decimals < 0 {
+		decimals = 0
+	}
+	power := math.Pow(10, decimals)
+
+	return math.Round(value*power) / power
+}
+
+//	logTicks generate the tick marks at the powers of the base of a logarithmic axis
+func (s *axisScale) logTicks(minorTics int) []axisTick {
+
+	const (
+		MAX_DECADES = 10
+	)
+
+	minExponent := math.Floor(s.transform(s.min))
+	maxExponent := math.Ceil(s.transform(s.max))
+
+	//	when the axis spans too many decades, skip some of them and the minor ticks
+	step := math.Ceil((maxExponent - minExponent) / MAX_DECADES)
+	if step < 1 {
+		step = 1
+	}
+
+	tick := make([]axisTick, 0)
+
+	for exponent := minExponent; exponent <= maxExponent; exponent += step {
+		value := math.Pow(s.base, exponent)
+
+		if s.inRange(value) {
+			tick = append(tick, axisTick{value: value})
+		}
+
+		//	minor ticks at the integer multiples of the power or at the requested intervals
+		if step > 1 || minorTics == MINOR_TICS_OFF || float64(minorTics)*(maxExponent-minExponent+1) > MAX_TICKS {
+			continue
+		}
+
+		var minorValue []float64
+
+		if minorTics > 0 {
+			for j := 1; j < minorTics; j++ {
+				minorValue = append(minorValue, value*(1+float64(j)*(s.base-1)/float64(minorTics)))
+			}
+		} else if s.base == math.Floor(s.base) {
+			for multiple := float64(2); multiple < s.base; multiple++ {
+				minorValue = append(minorValue, multiple*value)
+			}
+		}
+
+		for _, value := range minorValue {
+			if s.inRange(value) {
+				tick = append(tick, axisTick{value: value, minor: true})
+			}
+		}
+	}
+
+	return tick
+}
+
+//	the mantissa specifier in a tick format (a literal %% is matched to be skipped)
+var mantissaSpecifierRegEx = regexp.MustCompile(`%%|%[-+ #0]*\d*(\.(\d*)){0,1}t`)
+
+//	mantissaPrecision get the number of decimal places of the mantissa in a tick format
+func mantissaPrecision(format string) int {
+
+	for _, match := range mantissaSpecifierRegEx.FindAllStringSubmatch(format, -1) {
+		if match[0] == "%%" {
+			continue
+		}
+		if len(match[1]) == 0 {
+			return 6
+		}
+
+		precision, _ := strconv.Atoi(match[2])
+
+		return precision
+	}
+
+	return 6
+}
+
+//	formatTick format a tick value using a gnuplot like format (%t is the mantissa and %T the power of 10)
+func formatTick(format string, value float64) (string, error) {
+
+	var mantissa, exponent float64
+
+	if value != 0 {
+		exponent = math.Floor(math.Log10(math.Abs(value)))
+		mantissa = value / math.Pow(10, exponent)
+
+		//	rounding the mantissa to it's precision may carry it to the next power of 10
+		scale := math.Pow(10, float64(mantissaPrecision(format)))
+		if math.Abs(math.Round(mantissa*scale)/scale) >= 10 {
+			mantissa /= 10
+			exponent++
+		}
+	}
+
+	var label strings.Builder
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			label.WriteByte(format[i])
+			continue
+		}
+
+		//	get the flags, width and precision of the specifier
+		j := i + 1
+		for j < len(format) && strings.IndexByte("-+ #0123456789.", format[j]) >= 0 {
+			j++
+		}
+		if j >= len(format) {
+			return "", errors.New("incomplete format specifier: " + format)
+		}
+
+		specifier := format[i:j]
+
+		switch format[j] {
+		case '%':
+			label.WriteByte('%')
+
+		case 'f', 'e', 'E', 'g', 'G':
+			label.WriteString(fmt.Sprintf(specifier+string(format[j]), value))
+
+		case 'h':
+			label.WriteString(fmt.Sprintf(specifier+"g", value))
+
+		case 'x', 'X', 'o':
+			label.WriteString(fmt.Sprintf(specifier+string(format[j]), int64(value)))
+
+		case 't':
+			label.WriteString(fmt.Sprintf(specifier+"f", mantissa))
+
+		case 'T':
+			//	precision is meaningless for the exponent
+			if dot := strings.IndexByte(specifier, '.'); dot >= 0 {
+				specifier = specifier[:dot]
+			}
+			label.WriteString(fmt.Sprintf(specifier+"d", int64(exponent)))
+
+		default:
+			return "", errors.New("invalid format specifier: " + format[i:j+1])
+		}
+
+		i = j
+	}
+
+	return label.String(), nil
+}
@@ -0,0 +1,258 @@
+////////////////////////////////////////////////////////////////////////////////
+//	ticks_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the generation and formatting of tick marks
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+//	TestNiceStep unit tests for niceStep()
+func TestNiceStep(t *testing.T) {
+
+	t.Run(">>> niceStep: steps of 1, 2 and 5 times a power of 10", func(t *testing.T) {
+
+		interval := []float64{0.628, 0.0001, 1.7, 3.1, 80, 0.00024}
+		want := []float64{0.5, 0.0001, 2, 5, 100, 0.0002}
+
+		for i := range interval {
+			got := niceStep(interval[i])
+			//	check the result
+			if math.Abs(want[i]-got) > 1e-12 {
+				t.Errorf("failed evaluating nice step for %f: expected: %f result: %f", interval[i], want[i], got)
+			}
+		}
+	})
+}
+
+//	TestLinearTicks unit tests for axisScale.ticks() in a linear axis
+func TestLinearTicks(t *testing.T) {
+
+	t.Run(">>> ticks: small float range", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{}, 0, 0.001, 0, 100)
+		want := []string{"0", "0.0001", "0.0002", "0.0003", "0.0004", "0.0005", "0.0006", "0.0007", "0.0008", "0.0009", "0.001"}
+
+		tick := testScale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)
+		if len(tick) != len(want) {
+			t.Errorf("failed generating ticks: expected: %d ticks result: %d", len(want), len(tick))
+			return
+		}
+
+		for i := range want {
+			if want[i] != tick[i].label {
+				t.Errorf("failed generating ticks: expected: %s result: %s", want[i], tick[i].label)
+			}
+		}
+	})
+
+	t.Run(">>> ticks: explicit start, increment and end", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{
+			Tics: &Tics_series{Start: 1, Increment: 2, End: 7},
+		}, 0, 10, 0, 100)
+		want := []string{"1", "3", "5", "7"}
+
+		tick := testScale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)
+		if len(tick) != len(want) {
+			t.Errorf("failed generating ticks: expected: %d ticks result: %d", len(want), len(tick))
+			return
+		}
+
+		for i := range want {
+			if want[i] != tick[i].label {
+				t.Errorf("failed generating ticks: expected: %s result: %s", want[i], tick[i].label)
+			}
+		}
+	})
+
+	t.Run(">>> ticks: labelled tics", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{
+			Tic_labels: []Tic_label{{Label: "low", Value: 0}, {Label: "high", Value: 10}, {Label: "out", Value: 20}},
+		}, 0, 10, 0, 100)
+		want := []string{"low", "high"}
+
+		tick := testScale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)
+		if len(tick) != len(want) {
+			t.Errorf("failed generating ticks: expected: %d ticks result: %d", len(want), len(tick))
+			return
+		}
+
+		for i := range want {
+			if want[i] != tick[i].label {
+				t.Errorf("failed generating ticks: expected: %s result: %s", want[i], tick[i].label)
+			}
+		}
+	})
+
+	t.Run(">>> ticks: minor tics", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{
+			Tics:       &Tics_series{Start: math.Inf(-1), Increment: 5, End: math.Inf(1)},
+			Minor_tics: 5,
+		}, 0, 10, 0, 100)
+
+		var major, minor int
+
+		for _, tick := range testScale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS) {
+			if tick.minor {
+				minor++
+			} else {
+				major++
+			}
+		}
+
+		//	check the result
+		if major != 3 || minor != 8 {
+			t.Errorf("failed generating ticks: expected: 3 major and 8 minor result: %d major and %d minor", major, minor)
+		}
+	})
+
+	t.Run(">>> ticks: increment too small for the range", func(t *testing.T) {
+
+		axis := &Axis{Tics: &Tics_series{Start: math.Inf(-1), Increment: 0.000001, End: math.Inf(1)}, Minor_tics: 1000000}
+		want := newAxisScale(&Axis{}, 0, 1000, 0, 100).ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)
+
+		tick := newAxisScale(axis, 0, 1000, 0, 100).ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)
+		//	check the result: the automatic increment is used, without minor ticks
+		if len(tick) != len(want) {
+			t.Errorf("failed generating ticks: expected: %d ticks result: %d", len(want), len(tick))
+			return
+		}
+		for i := range want {
+			if want[i].label != tick[i].label || tick[i].minor {
+				t.Errorf("failed generating ticks: expected: %s result: %s", want[i].label, tick[i].label)
+			}
+		}
+
+		min, max := axis.roundRange(0.3, 999.7, MIN_X_SCALE_DIVISIONS)
+		want_min, want_max := (&Axis{}).roundRange(0.3, 999.7, MIN_X_SCALE_DIVISIONS)
+		if min != want_min || max != want_max {
+			t.Errorf("failed rounding range: expected: %f, %f result: %f, %f", want_min, want_max, min, max)
+		}
+
+		logScale := newAxisScale(&Axis{Log_scale: true, Log_base: 10, Minor_tics: 1000000}, 1, 1000, 0, 100)
+		if got := len(logScale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)); got != 4 {
+			t.Errorf("failed generating log ticks: expected 4 major ticks result: %d ticks", got)
+		}
+	})
+
+	t.Run(">>> ticks: range that is not finite", func(t *testing.T) {
+
+		for _, limits := range [][2]float64{{math.NaN(), math.NaN()}, {0, math.NaN()}, {math.Inf(-1), 1}, {0, math.Inf(1)}} {
+			testScale := newAxisScale(&Axis{}, limits[0], limits[1], 0, 100)
+
+			tick := testScale.ticks(MIN_X_SCALE_DIVISIONS, MAX_X_SCALE_DIVISIONS)
+			//	check the result
+			if len(tick) != 0 {
+				t.Errorf("failed generating ticks: expected no ticks for range %v result: %d ticks", limits, len(tick))
+			}
+		}
+	})
+}
+
+//	TestLogTicks unit tests for axisScale.logTicks()
+func TestLogTicks(t *testing.T) {
+
+	t.Run(">>> logTicks: major and minor ticks in base 10", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{Log_scale: true}, 1, 100, 0, 100)
+
+		var major, minor int
+
+		for _, tick := range testScale.logTicks(MINOR_TICS_DEFAULT) {
+			if tick.minor {
+				minor++
+			} else {
+				major++
+			}
+		}
+
+		//	check the result
+		if major != 3 || minor != 16 {
+			t.Errorf("failed generating log ticks: expected: 3 major and 16 minor result: %d major and %d minor", major, minor)
+		}
+	})
+
+	t.Run(">>> logTicks: base 2", func(t *testing.T) {
+
+		testScale := newAxisScale(&Axis{Log_scale: true, Log_base: 2}, 1, 16, 0, 100)
+		want := []string{"1", "2", "4", "8", "16"}
+
+		tick := testScale.ticks(MIN_Y_SCALE_DIVISIONS, MAX_Y_SCALE_DIVISIONS)
+		if len(tick) != len(want) {
+			t.Errorf("failed generating log ticks: expected: %d ticks result: %d", len(want), len(tick))
+			return
+		}
+
+		for i := range want {
+			if want[i] != tick[i].label {
+				t.Errorf("failed generating log ticks: expected: %s result: %s", want[i], tick[i].label)
+			}
+		}
+	})
+}
+
+//	TestFormatTick unit tests for formatTick()
+func TestFormatTick(t *testing.T) {
+
+	t.Run(">>> formatTick: gnuplot format specifiers", func(t *testing.T) {
+
+		format := []string{"%.2f", "%g", "%.1tx10^%T", "%T", "%5.1f%%", "value: %h"}
+		value := []float64{3.14159, 0.0001, 25000, 0.002, 12.34, 1e6}
+		want := []string{"3.14", "0.0001", "2.5x10^4", "-3", " 12.3%", "value: 1e+06"}
+
+		for i := range format {
+			got, err := formatTick(format[i], value[i])
+			if err != nil {
+				t.Errorf("fail formatting tick: %s", err.Error())
+				continue
+			}
+
+			//	check the result
+			if want[i] != got {
+				t.Errorf("failed formatting tick with %s: expected: '%s' result: '%s'", format[i], want[i], got)
+			}
+		}
+	})
+
+	t.Run(">>> formatTick: mantissa rounded to the next power of 10", func(t *testing.T) {
+
+		format := []string{"%.1t*10^%T", "%.0t*10^%T", "%T: %.2t", "%.3t*10^%T"}
+		value := []float64{0.00099999, -96, 9.999, 0.0009999}
+		want := []string{"1.0*10^-3", "-1*10^2", "1: 1.00", "9.999*10^-4"}
+
+		for i := range format {
+			got, err := formatTick(format[i], value[i])
+			if err != nil {
+				t.Errorf("fail formatting tick: %s", err.Error())
+				continue
+			}
+
+			//	check the result
+			if want[i] != got {
+				t.Errorf("failed formatting tick with %s: expected: '%s' result: '%s'", format[i], want[i], got)
+			}
+		}
+	})
+
+	t.Run(">>> formatTick: invalid format specifier", func(t *testing.T) {
+
+		want := "invalid format specifier: %s"
+
+		_, err := formatTick("%s", 1)
+		if err == nil {
+			t.Errorf("error expected for this test scenario")
+			return
+		}
+
+		if want != err.Error() {
+			t.Errorf("failed formatting tick: expected error: %s result: %s", want, err.Error())
+		}
+	})
+}
@@ -0,0 +1,170 @@
+////////////////////////////////////////////////////////////////////////////////
+//	vectors.go  -  Oct-19-2026  -  aldebap
+//
+//	Vectors style of a 2D Go-Plot
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+
+	"github.com/aldebap/go-plot/expression"
+)
+
+//	attributes used to describe the arrows of vectors (the head length is in units of the x axis and it's angle
+//	in degrees, using the size of the heads of arrows when not informed)
+type Vector_style struct {
+	Head        uint8
+	Head_length float64
+	Head_angle  float64
+}
+
+//	headSize return the length in pixels and the half opening angle in radians of the heads of the vectors
+func (style *Vector_style) headSize(x_scale *axisScale) (float64, float64) {
+
+	length := float64(ARROW_HEAD_LENGTH)
+	if style.Head_length > 0 {
+		length = math.Abs(x_scale.scale(x_scale.min+style.Head_length) - x_scale.scale(x_scale.min))
+	}
+
+	angle := float64(ARROW_HEAD_ANGLE)
+	if style.Head_angle > 0 {
+		angle = style.Head_angle * math.Pi / 180
+	}
+
+	return length, angle
+}
+
+//	newVectorPoint create a point with a vector, from the point to the tip of the vector
+func newVectorPoint(x, y, dx, dy float64) Point_2d {
+
+	x_to := x + dx
+	y_to := y + dy
+
+	return Point_2d{X: x, Y: y, X_to: &x_to, Y_to: &y_to}
+}
+
+//	NewVectorField create a set of vectors evaluating the expressions of their x and y components for each point
+//	of a grid with the informed number of columns and rows, where the variables of the expressions are x and y
+func NewVectorField(function_dx, function_dy string, min_x, max_x, min_y, max_y float64, columns, rows int) (*Set_points_2d, error) {
+
+	if columns < 2 || rows < 2 {
+		return nil, errors.New("a vector field requires at least two columns and two rows")
+	}
+	if min_x >= max_x || min_y >= max_y {
+		return nil, errors.New("invalid vector field range")
+	}
+
+	dxExpr, err := expression.NewExpression(function_dx)
+	if err != nil {
+		return nil, errors.New("error parsing vector field function: " + err.Error())
+	}
+	dyExpr, err := expression.NewExpression(function_dy)
+	if err != nil {
+		return nil, errors.New("error parsing vector field function: " + err.Error())
+	}
+
+	//	create the symbol table
+	symbolTable := expression.NewFloatSymbolTable()
+
+	expression.AddStandardMathFuncs(symbolTable)
+
+	set := &Set_points_2d{
+		Title: function_dx + ", " + function_dy,
+		Style: VECTORS,
+		Point: make([]Point_2d, 0, columns*rows),
+	}
+
+	for j := 0; j < rows; j++ {
+		y := min_y + (max_y-min_y)*float64(j)/float64(rows-1)
+
+		for i := 0; i < columns; i++ {
+			x := min_x + (max_x-min_x)*float64(i)/float64(columns-1)
+
+			symbolTable.SetValue("x", x)
+			symbolTable.SetValue("y", y)
+
+			dx, err := dxExpr.Evaluate(symbolTable)
+			if err != nil {
+				return nil, errors.New("error evaluating vector field function: " + err.Error())
+			}
+			dy, err := dyExpr.Evaluate(symbolTable)
+			if err != nil {
+				return nil, errors.New("error evaluating vector field function: " + err.Error())
+			}
+
+			set.Point = append(set.Point, newVectorPoint(x, y, dx, dy))
+		}
+	}
+
+	return set, nil
+}
+
+//	generateVectors generate an arrow from each point of the set to the tip of it's vector
+func (set *Set_points_2d) generateVectors(driver GraphicsDriver, x_scale, y_scale *axisScale, colour RGB_colour) {
+
+	length, angle := set.Vector.headSize(x_scale)
+
+	for _, point := range set.Point {
+		if point.X_to == nil || point.Y_to == nil {
+			continue
+		}
+
+		x1, y1 := x_scale.scale(point.X), y_scale.scale(point.Y)
+		x2, y2 := x_scale.scale(*point.X_to), y_scale.scale(*point.Y_to)
+
+		driver.Line(int64(x1), int64(y1), int64(x2), int64(y2), colour)
+
+		//	null vectors have no direction for their heads
+		if x1 == x2 && y1 == y2 {
+			continue
+		}
+		if set.Vector.Head == ARROW_HEAD || set.Vector.Head == ARROW_HEADS {
+			generateArrowHead(driver, x1, y1, x2, y2, length, angle, colour)
+		}
+		if set.Vector.Head == ARROW_BACKHEAD || set.Vector.Head == ARROW_HEADS {
+			generateArrowHead(driver, x2, y2, x1, y1, length, angle, colour)
+		}
+	}
+}
+
+//	parseVectorStyle parse the options of the vectors style: [head|nohead|heads|backhead] [size length,angle]
+func parseVectorStyle(vector *Vector_style, options string) (int, error) {
+
+	vectorOptionRegEx, err := regexp.Compile(`^\s*(heads|head|nohead|backhead|size\s+([0-9.]+)\s*,\s*([0-9.]+))(\s+|$)`)
+	if err != nil {
+		return 0, err
+	}
+
+	length := 0
+
+	for {
+		match := vectorOptionRegEx.FindAllStringSubmatch(options[length:], -1)
+		if len(match) != 1 {
+			break
+		}
+
+		switch match[0][1] {
+		case "head", "nohead", "heads", "backhead":
+			vector.Head = Arrow_head[match[0][1]]
+
+		default:
+			vector.Head_length, err = strconv.ParseFloat(match[0][2], 64)
+			if err != nil {
+				return 0, errors.New("invalid vector head size: " + match[0][1])
+			}
+			vector.Head_angle, err = strconv.ParseFloat(match[0][3], 64)
+			if err != nil {
+				return 0, errors.New("invalid vector head size: " + match[0][1])
+			}
+		}
+
+		length += len(match[0][0])
+	}
+
+	return length, nil
+}
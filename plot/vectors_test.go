@@ -0,0 +1,85 @@
+////////////////////////////////////////////////////////////////////////////////
+//	vectors_test.go  -  Oct-19-2026  -  aldebap
+//
+//	Test cases for the vectors style
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//	TestVectors unit tests for the vectors style
+func TestVectors(t *testing.T) {
+
+	t.Run(">>> NewVectorField: vectors in a grid", func(t *testing.T) {
+
+		set, err := NewVectorField("y", "2*x", 0, 2, 0, 1, 3, 2)
+		if err != nil {
+			t.Errorf("fail creating the vector field: %s", err.Error())
+			return
+		}
+
+		//	check the result
+		if len(set.Point) != 6 || set.Style != VECTORS {
+			t.Errorf("failed creating the vector field: expected: 6 vectors result: %d", len(set.Point))
+			return
+		}
+
+		want := newVectorPoint(2, 1, 1, 4)
+		got := set.Point[5]
+		if want.X != got.X || want.Y != got.Y || *want.X_to != *got.X_to || *want.Y_to != *got.Y_to {
+			t.Errorf("failed creating the vector field: expected: %v result: %v", want, got)
+		}
+	})
+
+	t.Run(">>> NewVectorField: invalid function", func(t *testing.T) {
+
+		_, err := NewVectorField("y+", "x", 0, 1, 0, 1, 2, 2)
+		//	check the result
+		if err == nil || !strings.HasPrefix(err.Error(), "error parsing vector field function") {
+			t.Errorf("failed creating the vector field: expected a parsing error result: %v", err)
+		}
+	})
+
+	t.Run(">>> parseVectorStyle: heads and size", func(t *testing.T) {
+
+		var got Vector_style
+
+		options := "heads size 0.5,20 title \"t\""
+		length, err := parseVectorStyle(&got, options)
+
+		want := Vector_style{Head: ARROW_HEADS, Head_length: 0.5, Head_angle: 20}
+		//	check the result
+		if err != nil || want != got || options[length:] != "title \"t\"" {
+			t.Errorf("failed parsing vectors options: expected: %v result: %v %d %v", want, got, length, err)
+		}
+	})
+
+	t.Run(">>> generateVectors: arrows without heads", func(t *testing.T) {
+
+		var output bytes.Buffer
+
+		writer := bufio.NewWriter(&output)
+		driver := NewSVG_Driver(writer, &TerminalOptions{Width: 100, Height: 100})
+		driver.SetDimensions(100, 100)
+
+		set := Set_points_2d{
+			Style:  VECTORS,
+			Vector: Vector_style{Head: ARROW_NOHEAD},
+			Point:  []Point_2d{newVectorPoint(0, 0, 5, 5)},
+		}
+		set.generateVectors(driver, newAxisScale(&Axis{}, 0, 10, 0, 100), newAxisScale(&Axis{}, 0, 10, 0, 100), RED)
+		writer.Flush()
+
+		want := `<line x1="0" y1="100" x2="50" y2="50"`
+		//	check the result
+		if !strings.Contains(output.String(), want) || strings.Contains(output.String(), "<polygon") {
+			t.Errorf("failed generating vectors: expected: %s result: %s", want, output.String())
+		}
+	})
+}
@@ -0,0 +1,130 @@
+////////////////////////////////////////////////////////////////////////////////
+//	viewportDriver.go  -  Oct-19-2026  -  aldebap
+//
+//	Graphic driver that draws into a rectangular area of another driver
+////////////////////////////////////////////////////////////////////////////////
+
+package plot
+
+type Viewport_Driver struct {
+	driver GraphicsDriver
+	x      int64
+	y      int64
+	width  int64
+	height int64
+}
+
+//	NewViewport_Driver create a new Viewport_Driver translating coordinates into an area of a driver
+func NewViewport_Driver(driver GraphicsDriver, x, y, width, height int64) GraphicsDriver {
+	return &Viewport_Driver{
+		driver: driver,
+		x:      x,
+		y:      y,
+		width:  width,
+		height: height,
+	}
+}
+
+//	GetDimensions get the dimensions of the viewport
+func (viewport *Viewport_Driver) GetDimensions() (width, heigth int64) {
+	return viewport.width, viewport.height
+}
+
+//	SetDimensions keep the dimensions of the viewport, as the underlying graphic is already initialized
+func (viewport *Viewport_Driver) SetDimensions(width int64, height int64) error {
+	return nil
+}
+
+//	GetFont get information about the font
+func (viewport *Viewport_Driver) GetFont() (fontFamily string, fontSize uint8) {
+	return viewport.driver.GetFont()
+}
+
+//	SetFont set information about the font
+func (viewport *Viewport_Driver) SetFont(fontFamily string, fontSize uint8) error {
+	return viewport.driver.SetFont(fontFamily, fontSize)
+}
+
+//	SetLineStyle set the width and dash type used to draw lines and paths
+func (viewport *Viewport_Driver) SetLineStyle(width float64, dashType uint8) error {
+	return viewport.driver.SetLineStyle(width, dashType)
+}
+
+//	Comment write a comment in the graphic
+func (viewport *Viewport_Driver) Comment(text string) {
+	viewport.driver.Comment(text)
+}
+
+//	Point draw a point in the viewport
+func (viewport *Viewport_Driver) Point(x, y int64, colour RGB_colour) error {
+	return viewport.driver.Point(viewport.x+x, viewport.y+y, colour)
+}
+
+//	BeginPath begin a path in the viewport
+func (viewport *Viewport_Driver) BeginPath(colour RGB_colour) error {
+	return viewport.driver.BeginPath(colour)
+}
+
+//	PointToPath add a point to the path
+func (viewport *Viewport_Driver) PointToPath(x, y int64) error {
+	return viewport.driver.PointToPath(viewport.x+x, viewport.y+y)
+}
+
+//	EndPath draw the path
+func (viewport *Viewport_Driver) EndPath() error {
+	return viewport.driver.EndPath()
+}
+
+//	Line draw a line in the viewport
+func (viewport *Viewport_Driver) Line(x1, y1, x2, y2 int64, colour RGB_colour) error {
+	return viewport.driver.Line(viewport.x+x1, viewport.y+y1, viewport.x+x2, viewport.y+y2, colour)
+}
+
+//	Polygon draw a polygon in the viewport
+func (viewport *Viewport_Driver) Polygon(point []DriverPoint, colour RGB_colour, filled bool) error {
+
+	translated := make([]DriverPoint, len(point))
+
+	for i := range point {
+		translated[i] = DriverPoint{X: viewport.x + point[i].X, Y: viewport.y + point[i].Y}
+	}
+
+	return viewport.driver.Polygon(translated, colour, filled)
+}
+
+//	FillPolygon draw the interior of a polygon in the viewport
+func (viewport *Viewport_Driver) FillPolygon(point []DriverPoint, colour RGB_colour, fill Fill_style) error {
+
+	translated := make([]DriverPoint, len(point))
+
+	for i := range point {
+		translated[i] = DriverPoint{X: viewport.x + point[i].X, Y: viewport.y + point[i].Y}
+	}
+
+	return viewport.driver.FillPolygon(translated, colour, fill)
+}
+
+//	Circle draw a circle in the viewport
+func (viewport *Viewport_Driver) Circle(x, y, radius int64, colour RGB_colour, filled bool) error {
+	return viewport.driver.Circle(viewport.x+x, viewport.y+y, radius, colour, filled)
+}
+
+//	Image draw a raster of colours in the viewport
+func (viewport *Viewport_Driver) Image(x, y, width, height int64, pixel [][]RGB_colour) error {
+	return viewport.driver.Image(viewport.x+x, viewport.y+y, width, height, pixel)
+}
+
+//	GetTextBox get the dimensions of a text
+func (viewport *Viewport_Driver) GetTextBox(text string) (width, height int64) {
+	return viewport.driver.GetTextBox(text)
+}
+
+//	Text draw a text in the viewport
+func (viewport *Viewport_Driver) Text(x, y, angle int64, text string, colour RGB_colour) error {
+	return viewport.driver.Text(viewport.x+x, viewport.y+y, angle, text, colour)
+}
+
+//	Close finish the viewport, leaving the underlying graphic to be closed by it's owner
+func (viewport *Viewport_Driver) Close() error {
+	return nil
+}